@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -32,9 +34,26 @@ type AccountDataSourceModel struct {
 	Email           types.String `tfsdk:"email"`
 	EmailVerifiedAt types.String `tfsdk:"email_verified_at"`
 	AddressLineOne  types.String `tfsdk:"address_line_one"`
-	// Teams           types.List   `tfsdk:"teams"`
-	AvatarUrl types.String `tfsdk:"avatar_url"`
-	Sandboxed types.Bool   `tfsdk:"is_sandboxed"`
+	Teams           types.List   `tfsdk:"teams"`
+	AvatarUrl       types.String `tfsdk:"avatar_url"`
+	Sandboxed       types.Bool   `tfsdk:"is_sandboxed"`
+}
+
+// AccountTeamModel describes a team nested in the account data model.
+type AccountTeamModel struct {
+	Id                       types.Int32  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	AwsId                    types.String `tfsdk:"aws_external_id"`
+	SentryOrganisationName   types.String `tfsdk:"sentry_organization_name"`
+	SentryOrganisationRegion types.String `tfsdk:"sentry_organization_region"`
+}
+
+var accountTeamAttrTypes = map[string]attr.Type{
+	"id":                         types.Int32Type,
+	"name":                       types.StringType,
+	"aws_external_id":            types.StringType,
+	"sentry_organization_name":   types.StringType,
+	"sentry_organization_region": types.StringType,
 }
 
 func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -67,11 +86,34 @@ func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Current user address",
 				Computed:            true,
 			},
-			// "teams": schema.ListAttribute{
-			// 	ElementType: ,
-			// 	MarkdownDescription: "Current user teams list",
-			// 	Computed:            true,
-			// },
+			"teams": schema.ListNestedAttribute{
+				MarkdownDescription: "Current user teams list",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Team ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Team name",
+							Computed:            true,
+						},
+						"aws_external_id": schema.StringAttribute{
+							MarkdownDescription: "Team AWS external ID",
+							Computed:            true,
+						},
+						"sentry_organization_name": schema.StringAttribute{
+							MarkdownDescription: "Team Sentry organization name",
+							Computed:            true,
+						},
+						"sentry_organization_region": schema.StringAttribute{
+							MarkdownDescription: "Team Sentry organization region",
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"avatar_url": schema.StringAttribute{
 				MarkdownDescription: "Current user avatar URL",
 				Computed:            true,
@@ -114,20 +156,18 @@ func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	account, err := d.client.GetAccount()
+	account, err := d.client.GetAccount(ctx)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account, got error: %s", err))
 		return
 	}
 
-	// For the purposes of this example code, hardcoding a response value to
-	// save into the Terraform state.
-	data.Id = types.Int32Value(int32(account.Id))
-	data.Email = types.StringValue(account.Email)
-	data.Name = types.StringValue(account.Name)
-	data.AvatarUrl = types.StringValue(account.AvatarUrl)
-	data.EmailVerifiedAt = types.StringValue(account.EmailVerifiedAt)
+	resp.Diagnostics.Append(data.setAccount(ctx, account)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -136,3 +176,31 @@ func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (data *AccountDataSourceModel) setAccount(ctx context.Context, account *Account) diag.Diagnostics {
+	teams := []AccountTeamModel{}
+
+	// Owned teams are not listed within the account teams
+	for _, team := range append(account.OwnedTeams, account.Teams...) {
+		teams = append(teams, AccountTeamModel{
+			Id:                       types.Int32Value(int32(team.Id)),
+			Name:                     types.StringValue(team.Name),
+			AwsId:                    types.StringValue(team.AwsId),
+			SentryOrganisationName:   types.StringValue(team.SentryOrganisationName),
+			SentryOrganisationRegion: types.StringValue(team.SentryOrganisationRegion),
+		})
+	}
+
+	teamsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: accountTeamAttrTypes}, teams)
+
+	data.Id = types.Int32Value(int32(account.Id))
+	data.Email = types.StringValue(account.Email)
+	data.Name = types.StringValue(account.Name)
+	data.AvatarUrl = types.StringValue(account.AvatarUrl)
+	data.EmailVerifiedAt = types.StringValue(account.EmailVerifiedAt)
+	data.AddressLineOne = types.StringValue(account.AddressLineOne)
+	data.Sandboxed = types.BoolValue(account.Sandboxed)
+	data.Teams = teamsValue
+
+	return diags
+}
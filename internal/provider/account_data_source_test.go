@@ -4,21 +4,36 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccAccountDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/user": testFixture(t, "user.json"),
+	})
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
 		Steps: []resource.TestStep{
 			// Read testing
 			{
 				Config: testAccAccountDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.laravelvapor_account.test", "id", "19870"),
+					resource.TestCheckResourceAttr("data.laravelvapor_account.test", "teams.#", "2"),
+					resource.TestCheckResourceAttr("data.laravelvapor_account.test", "teams.1.name", "Terraformers"),
+					resource.TestCheckResourceAttr("data.laravelvapor_account.test", "address_line_one", ""),
+					resource.TestCheckResourceAttr("data.laravelvapor_account.test", "is_sandboxed", "true"),
 				),
 			},
 		},
@@ -28,3 +43,49 @@ func TestAccAccountDataSource(t *testing.T) {
 const testAccAccountDataSourceConfig = `
 data "laravelvapor_account" "test" {}
 `
+
+func TestAccountDataSourceSetAccountFillsAllAttributes(t *testing.T) {
+	ctx := context.Background()
+
+	account := Account{}
+
+	if err := json.Unmarshal([]byte(testFixture(t, "user.json")), &account); err != nil {
+		t.Fatal(err)
+	}
+
+	data := AccountDataSourceModel{}
+
+	if diags := data.setAccount(ctx, &account); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	schemaResp := datasource.SchemaResponse{}
+
+	NewAccountDataSource().Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Every declared attribute must be read from the account, none is left null
+	for name := range schemaResp.Schema.Attributes {
+		var value attr.Value
+
+		if diags := state.GetAttribute(ctx, path.Root(name), &value); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if value.IsNull() || value.IsUnknown() {
+			t.Errorf("expected %s to be set, got %s", name, value)
+		}
+	}
+
+	if !data.Sandboxed.ValueBool() {
+		t.Error("expected is_sandboxed to be read from the account")
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &AccountEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AccountEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &AccountEphemeralResource{}
+
+func NewAccountEphemeralResource() ephemeral.EphemeralResource {
+	return &AccountEphemeralResource{}
+}
+
+// AccountEphemeralResource defines the ephemeral resource implementation.
+type AccountEphemeralResource struct {
+	client VaporClient
+}
+
+func (r *AccountEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (r *AccountEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Get user account information without storing it in state",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Current user ID",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Current user name",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Current user email",
+				Computed:            true,
+			},
+			"email_verified_at": schema.StringAttribute{
+				MarkdownDescription: "Current user email verified date time",
+				Computed:            true,
+			},
+			"address_line_one": schema.StringAttribute{
+				MarkdownDescription: "Current user address",
+				Computed:            true,
+			},
+			"teams": schema.ListNestedAttribute{
+				MarkdownDescription: "Current user teams list",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Team ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Team name",
+							Computed:            true,
+						},
+						"aws_external_id": schema.StringAttribute{
+							MarkdownDescription: "Team AWS external ID",
+							Computed:            true,
+						},
+						"sentry_organization_name": schema.StringAttribute{
+							MarkdownDescription: "Team Sentry organization name",
+							Computed:            true,
+						},
+						"sentry_organization_region": schema.StringAttribute{
+							MarkdownDescription: "Team Sentry organization region",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"avatar_url": schema.StringAttribute{
+				MarkdownDescription: "Current user avatar URL",
+				Computed:            true,
+			},
+			"is_sandboxed": schema.BoolAttribute{
+				MarkdownDescription: "Is current user account sandboxed",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccountEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccountEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Account details are the same as the account data source ones
+	var data AccountDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := r.client.GetAccount(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.setAccount(ctx, account)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "opened an account ephemeral resource")
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *AccountEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	// Nothing was created upstream, there is nothing to release
+}
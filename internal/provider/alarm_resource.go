@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AlarmResource{}
+
+func NewAlarmResource() resource.Resource {
+	return &AlarmResource{}
+}
+
+// AlarmResource defines the resource implementation.
+type AlarmResource struct {
+	client VaporClient
+}
+
+// AlarmResourceModel describes the resource data model.
+type AlarmResourceModel struct {
+	Id                 types.Int32   `tfsdk:"id"`
+	TeamId             types.Int32   `tfsdk:"team_id"`
+	Metric             types.String  `tfsdk:"metric"`
+	Operator           types.String  `tfsdk:"operator"`
+	Threshold          types.Float64 `tfsdk:"threshold"`
+	Period             types.Int32   `tfsdk:"period"`
+	NotificationTarget types.String  `tfsdk:"notification_target"`
+	Status             types.String  `tfsdk:"status"`
+}
+
+func (r *AlarmResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alarm"
+}
+
+func (r *AlarmResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a CloudWatch metric alarm of a team",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Alarm ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID the alarm belongs to, defaults to the provider `team_id`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"metric": schema.StringAttribute{
+				MarkdownDescription: "Metric watched by the alarm",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"operator": schema.StringAttribute{
+				MarkdownDescription: "Comparison between the metric and the threshold, one of `GreaterThanOrEqualToThreshold`, `GreaterThanThreshold`, `LessThanThreshold` or `LessThanOrEqualToThreshold`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(alarmOperators...),
+				},
+			},
+			"threshold": schema.Float64Attribute{
+				MarkdownDescription: "Value the metric is compared against",
+				Required:            true,
+			},
+			"period": schema.Int32Attribute{
+				MarkdownDescription: "Period in seconds the metric is evaluated over, CloudWatch metrics have a one minute resolution",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(60),
+				},
+			},
+			"notification_target": schema.StringAttribute{
+				MarkdownDescription: "Destination notified when the alarm is triggered",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Alarm status",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AlarmResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AlarmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AlarmResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId, diags := teamIdOrDefault(data.TeamId, r.client)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamId = teamId
+
+	alarm, err := r.client.CreateAlarm(ctx, int(data.TeamId.ValueInt32()), data.toAlarm())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create alarm", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(alarm.Id))
+	data.Status = types.StringValue(alarm.Status)
+
+	tflog.Trace(ctx, "created an alarm resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlarmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AlarmResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarm, err := r.client.GetAlarm(ctx, int(data.Id.ValueInt32()))
+
+	// Alarm was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read alarm", err, data.identity()))
+		return
+	}
+
+	data.Metric = types.StringValue(alarm.Metric)
+	data.Operator = types.StringValue(alarm.Operator)
+	data.Threshold = types.Float64Value(alarm.Threshold)
+	data.Period = types.Int32Value(int32(alarm.Period))
+	data.Status = types.StringValue(alarm.Status)
+
+	// Notification target is not always returned, the configured one is kept then
+	if alarm.NotificationTarget != "" {
+		data.NotificationTarget = types.StringValue(alarm.NotificationTarget)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlarmResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AlarmResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarm, err := r.client.UpdateAlarm(ctx, int(data.Id.ValueInt32()), data.toAlarm())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("update alarm", err, data.identity()))
+		return
+	}
+
+	data.Status = types.StringValue(alarm.Status)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AlarmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AlarmResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveAlarm(ctx, int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete alarm", err, data.identity()))
+		return
+	}
+}
+
+func (data *AlarmResourceModel) toAlarm() VaporAlarm {
+	return VaporAlarm{
+		TeamId:             FlexInt(data.TeamId.ValueInt32()),
+		Metric:             data.Metric.ValueString(),
+		Operator:           data.Operator.ValueString(),
+		Threshold:          data.Threshold.ValueFloat64(),
+		Period:             int(data.Period.ValueInt32()),
+		NotificationTarget: data.NotificationTarget.ValueString(),
+	}
+}
+
+// identity returns the attributes identifying the alarm in diagnostics.
+func (data *AlarmResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":      data.Id,
+		"team_id": data.TeamId,
+		"metric":  data.Metric,
+	}
+}
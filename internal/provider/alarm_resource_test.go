@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccAlarmResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/teams/79169/alarms": `{"id": 41, "team_id": 79169, "metric": "errors", "operator": "GreaterThanThreshold", "threshold": 10, "period": 300, "notification_target": "ops@example.com", "status": "INSUFFICIENT_DATA"}`,
+		"GET /api/alarms/41":           `{"id": 41, "team_id": 79169, "metric": "errors", "operator": "GreaterThanThreshold", "threshold": 10, "period": 300, "notification_target": "ops@example.com", "status": "OK"}`,
+		"PUT /api/alarms/41":           `{"id": 41, "team_id": 79169, "metric": "errors", "operator": "GreaterThanOrEqualToThreshold", "threshold": 25, "period": 300, "notification_target": "ops@example.com", "status": "OK"}`,
+		"DELETE /api/alarms/41":        ``,
+	})
+
+	server.setRouteAfter("PUT /api/alarms/41", "GET /api/alarms/41", `{"id": 41, "team_id": 79169, "metric": "errors", "operator": "GreaterThanOrEqualToThreshold", "threshold": 25, "period": 300, "notification_target": "ops@example.com", "status": "OK"}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Unknown operator testing
+			{
+				Config:      testAccAlarmResourceConfig(">", 10),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccAlarmResourceConfig("GreaterThanThreshold", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/teams/79169/alarms", `{"metric": "errors", "operator": "GreaterThanThreshold", "threshold": 10, "period": 300, "notification_target": "ops@example.com"}`),
+					resource.TestCheckResourceAttr("laravelvapor_alarm.test", "id", "41"),
+					resource.TestCheckResourceAttr("laravelvapor_alarm.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("laravelvapor_alarm.test", "metric", "errors"),
+					resource.TestCheckResourceAttr("laravelvapor_alarm.test", "operator", "GreaterThanThreshold"),
+					resource.TestCheckResourceAttr("laravelvapor_alarm.test", "threshold", "10"),
+					resource.TestCheckResourceAttr("laravelvapor_alarm.test", "period", "300"),
+					resource.TestCheckResourceAttr("laravelvapor_alarm.test", "status", "INSUFFICIENT_DATA"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccAlarmResourceConfig("GreaterThanOrEqualToThreshold", 25),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_alarm.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/alarms/41", `{"metric": "errors", "operator": "GreaterThanOrEqualToThreshold", "threshold": 25, "period": 300, "notification_target": "ops@example.com"}`),
+					resource.TestCheckResourceAttr("laravelvapor_alarm.test", "operator", "GreaterThanOrEqualToThreshold"),
+					resource.TestCheckResourceAttr("laravelvapor_alarm.test", "threshold", "25"),
+					resource.TestCheckResourceAttr("laravelvapor_alarm.test", "status", "OK"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAlarmResourceConfig(operator string, threshold int) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_alarm" "test" {
+  team_id             = 79169
+  metric              = "errors"
+  operator            = %[1]q
+  threshold           = %[2]d
+  period              = 300
+  notification_target = "ops@example.com"
+}
+`, operator, threshold)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BalancersDataSource{}
+
+func NewBalancersDataSource() datasource.DataSource {
+	return &BalancersDataSource{}
+}
+
+// BalancersDataSource defines the data source implementation.
+type BalancersDataSource struct {
+	client VaporClient
+}
+
+// BalancersDataSourceModel describes the data source data model.
+type BalancersDataSourceModel struct {
+	TeamId    types.Int32 `tfsdk:"team_id"`
+	Balancers types.List  `tfsdk:"balancers"`
+}
+
+// BalancerModel describes a load balancer object in data source models.
+type BalancerModel struct {
+	Id     types.Int32  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Region types.String `tfsdk:"region"`
+	Status types.String `tfsdk:"status"`
+}
+
+var balancerAttrTypes = map[string]attr.Type{
+	"id":     types.Int32Type,
+	"name":   types.StringType,
+	"region": types.StringType,
+	"status": types.StringType,
+}
+
+func (d *BalancersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_balancers"
+}
+
+func (d *BalancersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "List all load balancers of a team",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID",
+				Required:            true,
+			},
+			"balancers": schema.ListNestedAttribute{
+				MarkdownDescription: "Load balancers list, ordered by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Load balancer ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Load balancer name",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Load balancer AWS region",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Load balancer status",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BalancersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BalancersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BalancersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	balancers, err := d.client.GetBalancers(ctx, int(data.TeamId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read load balancers, got error: %s", err))
+		return
+	}
+
+	// Keep a stable order between reads to prevent spurious diffs
+	sort.SliceStable(balancers, func(i, j int) bool {
+		return balancers[i].Id < balancers[j].Id
+	})
+
+	balancerModels := []BalancerModel{}
+
+	for _, balancer := range balancers {
+		balancerModels = append(balancerModels, BalancerModel{
+			Id:     types.Int32Value(int32(balancer.Id)),
+			Name:   types.StringValue(balancer.Name),
+			Region: types.StringValue(balancer.Region),
+			Status: types.StringValue(balancer.Status),
+		})
+	}
+
+	balancersValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: balancerAttrTypes}, balancerModels)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Balancers = balancersValue
+
+	tflog.Trace(ctx, "read balancers data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBalancersDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/teams/79169/balancers": `[{"id": 12, "team_id": 79169, "cloud_provider_id": 7, "name": "production", "region": "eu-west-1", "status": "available"}]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBalancersDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_balancers.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("data.laravelvapor_balancers.test", "balancers.#", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_balancers.test", "balancers.0.id", "12"),
+					resource.TestCheckResourceAttr("data.laravelvapor_balancers.test", "balancers.0.name", "production"),
+					resource.TestCheckResourceAttr("data.laravelvapor_balancers.test", "balancers.0.region", "eu-west-1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_balancers.test", "balancers.0.status", "available"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBalancersDataSourceConfig = `
+data "laravelvapor_balancers" "test" {
+  team_id = 79169
+}
+`
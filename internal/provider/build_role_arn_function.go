@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &BuildRoleArnFunction{}
+
+var awsAccountIdPattern = regexp.MustCompile(`^\d{12}$`)
+
+func NewBuildRoleArnFunction() function.Function {
+	return &BuildRoleArnFunction{}
+}
+
+// BuildRoleArnFunction defines the function implementation.
+type BuildRoleArnFunction struct{}
+
+func (f *BuildRoleArnFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_role_arn"
+}
+
+func (f *BuildRoleArnFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build an AWS IAM role ARN",
+		MarkdownDescription: "Build the ARN of an AWS IAM role from its account ID and role name, to use as a cloud provider `role_arn`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "account_id",
+				MarkdownDescription: "AWS account ID (12 digits)",
+			},
+			function.StringParameter{
+				Name:                "role_name",
+				MarkdownDescription: "IAM role name",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildRoleArnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var accountId, roleName string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &accountId, &roleName))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if !awsAccountIdPattern.MatchString(accountId) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("AWS account ID must be 12 digits, got: %q", accountId))
+		return
+	}
+
+	if roleName == "" {
+		resp.Error = function.NewArgumentFuncError(1, "IAM role name must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, "arn:aws:iam::"+accountId+":role/"+roleName))
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildRoleArnFunction(t *testing.T) {
+	tests := map[string]struct {
+		accountId string
+		roleName  string
+		expected  string
+		wantError bool
+	}{
+		"valid":               {accountId: "123456789012", roleName: "laravel-vapor-role", expected: "arn:aws:iam::123456789012:role/laravel-vapor-role"},
+		"short account id":    {accountId: "12345", roleName: "laravel-vapor-role", wantError: true},
+		"non numeric account": {accountId: "12345678901a", roleName: "laravel-vapor-role", wantError: true},
+		"empty role name":     {accountId: "123456789012", roleName: "", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(test.accountId),
+					types.StringValue(test.roleName),
+				}),
+			}
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			NewBuildRoleArnFunction().Run(context.Background(), req, &resp)
+
+			if test.wantError {
+				if resp.Error == nil {
+					t.Fatal("expected an error, got none")
+				}
+
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			if !resp.Result.Value().Equal(types.StringValue(test.expected)) {
+				t.Errorf("expected %s, got %s", test.expected, resp.Result.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CacheResource{}
+
+func NewCacheResource() resource.Resource {
+	return &CacheResource{}
+}
+
+// CacheResource defines the resource implementation.
+type CacheResource struct {
+	client VaporClient
+}
+
+// CacheResourceModel describes the resource data model.
+type CacheResourceModel struct {
+	Id              types.Int32    `tfsdk:"id"`
+	TeamId          types.Int32    `tfsdk:"team_id"`
+	Name            types.String   `tfsdk:"name"`
+	Type            types.String   `tfsdk:"type"`
+	Region          types.String   `tfsdk:"region"`
+	CloudProviderId types.Int32    `tfsdk:"cloud_provider_id"`
+	NodeType        types.String   `tfsdk:"node_type"`
+	Status          types.String   `tfsdk:"status"`
+	Endpoint        types.String   `tfsdk:"endpoint"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CacheResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cache"
+}
+
+func (r *CacheResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a Redis cache cluster, creation waits until the cache is available",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Cache ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID the cache belongs to, defaults to the provider `team_id`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Cache name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Cache type (e.g. `redis-cluster`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Cache AWS region (e.g. `us-east-1`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_provider_id": schema.Int32Attribute{
+				MarkdownDescription: "Cloud provider ID the cache is created in",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"node_type": schema.StringAttribute{
+				MarkdownDescription: "Cache node type (e.g. `cache.t3.micro`), changing it scales the cache in place",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Cache status",
+				Computed:            true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Cache endpoint host",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *CacheResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CacheResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CacheResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId, diags := teamIdOrDefault(data.TeamId, r.client)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamId = teamId
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cache, err := r.client.CreateCache(ctx, int(data.TeamId.ValueInt32()), VaporCache{
+		CloudProviderId: FlexInt(data.CloudProviderId.ValueInt32()),
+		Name:            data.Name.ValueString(),
+		Type:            data.Type.ValueString(),
+		Region:          data.Region.ValueString(),
+		NodeType:        data.NodeType.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create cache", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(cache.Id))
+
+	// Nothing was sent in dry run mode, there is nothing to wait for
+	if r.client.dryRun {
+		data.setComputed(cache)
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	_, err = waitForStatus(waitCtx, defaultPollInterval, []string{"creating"}, func() (string, error) {
+		cache, err = r.client.GetCache(waitCtx, int(data.Id.ValueInt32()))
+
+		return cache.Status, err
+	})
+
+	data.setComputed(cache)
+
+	// Cache is kept in state even when provisioning fails, so it is tainted instead of leaked
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("wait for cache creation", err, data.identity()))
+	} else if cache.Status != "available" {
+		resp.Diagnostics.AddError("Cache Creation Failed", fmt.Sprintf("Cache creation ended with status %q instead of available.", cache.Status))
+	}
+
+	tflog.Trace(ctx, "created a cache resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CacheResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cache, err := r.client.GetCache(ctx, int(data.Id.ValueInt32()))
+
+	// Cache was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read cache", err, data.identity()))
+		return
+	}
+
+	if cache.NodeType != "" {
+		data.NodeType = types.StringValue(cache.NodeType)
+	}
+
+	data.setComputed(cache)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CacheResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the node type can change without replacement
+	cache, err := r.client.UpdateCache(ctx, int(data.Id.ValueInt32()), data.NodeType.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("update cache", err, data.identity()))
+		return
+	}
+
+	data.setComputed(cache)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CacheResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CacheResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveCache(ctx, int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete cache", err, data.identity()))
+		return
+	}
+}
+
+func (data *CacheResourceModel) setComputed(cache *VaporCache) {
+	data.Status = types.StringValue(cache.Status)
+
+	// Endpoint is only known once the cache is provisioned
+	if cache.Endpoint != "" || data.Endpoint.IsUnknown() {
+		data.Endpoint = types.StringValue(cache.Endpoint)
+	}
+}
+
+// identity returns the attributes identifying the cache in diagnostics.
+func (data *CacheResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":      data.Id,
+		"team_id": data.TeamId,
+		"name":    data.Name,
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccCacheResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/teams/79169/caches": `{"id": 23, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "type": "redis-cluster", "region": "us-east-1", "instance_class": "cache.t3.micro", "status": "creating"}`,
+		"GET /api/caches/23":           `{"id": 23, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "type": "redis-cluster", "region": "us-east-1", "instance_class": "cache.t3.micro", "status": "available", "endpoint": "terraform.abc123.cache.amazonaws.com"}`,
+		"PUT /api/caches/23/size":      `{"id": 23, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "type": "redis-cluster", "region": "us-east-1", "instance_class": "cache.t3.small", "status": "modifying", "endpoint": "terraform.abc123.cache.amazonaws.com"}`,
+		"DELETE /api/caches/23":        ``,
+	})
+
+	server.setRouteAfter("PUT /api/caches/23/size", "GET /api/caches/23", `{"id": 23, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "type": "redis-cluster", "region": "us-east-1", "instance_class": "cache.t3.small", "status": "available", "endpoint": "terraform.abc123.cache.amazonaws.com"}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCacheResourceConfig("cache.t3.micro"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/teams/79169/caches", `{"cloud_provider_id": 1, "name": "terraform", "type": "redis-cluster", "region": "us-east-1", "instance_class": "cache.t3.micro"}`),
+					resource.TestCheckResourceAttr("laravelvapor_cache.test", "id", "23"),
+					resource.TestCheckResourceAttr("laravelvapor_cache.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("laravelvapor_cache.test", "name", "terraform"),
+					resource.TestCheckResourceAttr("laravelvapor_cache.test", "node_type", "cache.t3.micro"),
+					resource.TestCheckResourceAttr("laravelvapor_cache.test", "status", "available"),
+					resource.TestCheckResourceAttr("laravelvapor_cache.test", "endpoint", "terraform.abc123.cache.amazonaws.com"),
+				),
+			},
+			// Scaling testing
+			{
+				Config: testAccCacheResourceConfig("cache.t3.small"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_cache.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/caches/23/size", `{"instance_class": "cache.t3.small"}`),
+					resource.TestCheckResourceAttr("laravelvapor_cache.test", "node_type", "cache.t3.small"),
+					resource.TestCheckResourceAttr("laravelvapor_cache.test", "status", "modifying"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCacheResourceConfig(nodeType string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_cache" "test" {
+  team_id           = 79169
+  name              = "terraform"
+  type              = "redis-cluster"
+  region            = "us-east-1"
+  cloud_provider_id = 1
+  node_type         = %[1]q
+
+  timeouts = {
+    create = "45m"
+  }
+}
+`, nodeType)
+}
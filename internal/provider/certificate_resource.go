@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CertificateResource{}
+
+func NewCertificateResource() resource.Resource {
+	return &CertificateResource{}
+}
+
+// CertificateResource defines the resource implementation.
+type CertificateResource struct {
+	client VaporClient
+}
+
+// CertificateResourceModel describes the resource data model.
+type CertificateResourceModel struct {
+	Id                types.Int32    `tfsdk:"id"`
+	TeamId            types.Int32    `tfsdk:"team_id"`
+	Domain            types.String   `tfsdk:"domain"`
+	AlternativeNames  types.List     `tfsdk:"alternative_names"`
+	WaitForIssued     types.Bool     `tfsdk:"wait_for_issued"`
+	Status            types.String   `tfsdk:"status"`
+	ValidationRecords types.List     `tfsdk:"validation_records"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// CertificateValidationRecordModel describes a DNS validation record object in certificate models.
+type CertificateValidationRecordModel struct {
+	Type  types.String `tfsdk:"type"`
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+var certificateValidationRecordAttrTypes = map[string]attr.Type{
+	"type":  types.StringType,
+	"name":  types.StringType,
+	"value": types.StringType,
+}
+
+func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a TLS certificate validated through DNS records",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Certificate ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID the certificate belongs to, defaults to the provider `team_id`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Certificate domain name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alternative_names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Certificate alternative domain names",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_issued": schema.BoolAttribute{
+				MarkdownDescription: "Wait on creation until the certificate is issued, its validation records must already exist",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Certificate status",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validation_records": schema.ListNestedAttribute{
+				MarkdownDescription: "DNS records to create for the certificate validation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Record type",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Record name",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Record value",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *CertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId, diags := teamIdOrDefault(data.TeamId, r.client)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamId = teamId
+
+	var alternativeNames []string
+
+	resp.Diagnostics.Append(data.AlternativeNames.ElementsAs(ctx, &alternativeNames, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate, err := r.client.CreateCertificate(ctx, int(data.TeamId.ValueInt32()), data.Domain.ValueString(), alternativeNames)
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create certificate", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(certificate.Id))
+
+	// Nothing was sent in dry run mode, there are no validation records to read or wait for
+	if r.client.dryRun {
+		resp.Diagnostics.Append(data.setComputed(ctx, certificate)...)
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	// Validation records are generated right after creation
+	certificate, err = r.client.GetCertificate(ctx, int(data.Id.ValueInt32()))
+
+	if err == nil && data.WaitForIssued.ValueBool() {
+		createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+
+		_, err = waitForStatus(waitCtx, defaultPollInterval, []string{"pending"}, func() (string, error) {
+			certificate, err = r.client.GetCertificate(waitCtx, int(data.Id.ValueInt32()))
+
+			return certificate.Status, err
+		})
+	}
+
+	resp.Diagnostics.Append(data.setComputed(ctx, certificate)...)
+
+	// Certificate is kept in state even when validation fails, so it is tainted instead of leaked
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read created certificate", err, data.identity()))
+	} else if data.WaitForIssued.ValueBool() && certificate.Status != "issued" {
+		resp.Diagnostics.AddError("Certificate Not Issued", fmt.Sprintf("Certificate validation ended with status %q instead of issued.", certificate.Status))
+	}
+
+	tflog.Trace(ctx, "created a certificate resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificate, err := r.client.GetCertificate(ctx, int(data.Id.ValueInt32()))
+
+	// Certificate was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read certificate", err, data.identity()))
+		return
+	}
+
+	data.Domain = types.StringValue(certificate.Domain)
+
+	resp.Diagnostics.Append(data.setComputed(ctx, certificate)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only creation options can change without replacement, nothing to update upstream
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveCertificate(ctx, int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete certificate", err, data.identity()))
+		return
+	}
+}
+
+func (data *CertificateResourceModel) setComputed(ctx context.Context, certificate *VaporCertificate) diag.Diagnostics {
+	recordModels := []CertificateValidationRecordModel{}
+
+	for _, record := range certificate.ValidationRecords {
+		recordModels = append(recordModels, CertificateValidationRecordModel{
+			Type:  types.StringValue(record.Type),
+			Name:  types.StringValue(record.Name),
+			Value: types.StringValue(record.Value),
+		})
+	}
+
+	records, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: certificateValidationRecordAttrTypes}, recordModels)
+
+	data.Status = types.StringValue(certificate.Status)
+	data.ValidationRecords = records
+
+	return diags
+}
+
+// identity returns the attributes identifying the certificate in diagnostics.
+func (data *CertificateResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":      data.Id,
+		"team_id": data.TeamId,
+		"domain":  data.Domain,
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCertificateResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/teams/79169/certificates": `{"id": 31, "team_id": 79169, "domain": "example.com", "alternative_names": ["*.example.com"], "status": "pending"}`,
+		"GET /api/certificates/31":           `{"id": 31, "team_id": 79169, "domain": "example.com", "alternative_names": ["*.example.com"], "status": "pending", "dns_validation_records": [{"type": "CNAME", "name": "_a1b2.example.com.", "value": "_c3d4.acm-validations.aws."}]}`,
+		"DELETE /api/certificates/31":        ``,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCertificateResourceConfig("example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/teams/79169/certificates", `{"domain": "example.com", "alternative_names": ["*.example.com"]}`),
+					resource.TestCheckResourceAttr("laravelvapor_certificate.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("laravelvapor_certificate.test", "domain", "example.com"),
+					resource.TestCheckResourceAttr("laravelvapor_certificate.test", "alternative_names.#", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_certificate.test", "id", "31"),
+					resource.TestCheckResourceAttr("laravelvapor_certificate.test", "status", "pending"),
+					resource.TestCheckResourceAttr("laravelvapor_certificate.test", "validation_records.#", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_certificate.test", "validation_records.0.type", "CNAME"),
+					resource.TestCheckResourceAttr("laravelvapor_certificate.test", "validation_records.0.name", "_a1b2.example.com."),
+					resource.TestCheckResourceAttr("laravelvapor_certificate.test", "validation_records.0.value", "_c3d4.acm-validations.aws."),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCertificateResourceConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_certificate" "test" {
+  team_id           = 79169
+  domain            = %[1]q
+  alternative_names = ["*.%[1]s"]
+}
+`, domain)
+}
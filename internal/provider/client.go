@@ -2,27 +2,91 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
-	"log"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type VaporClient struct {
 	apiToken string
 	apiHost  string
 
+	// apiBasePath is prepended to every request path, after the host path
+	apiBasePath string
+
+	// defaultTeamId is used by resources without their own team ID, zero when unset
+	defaultTeamId int
+
+	// dryRun skips mutating requests, only logging them, reads are still sent
+	dryRun bool
+
+	// MaxRetries is the number of times a rate limited or server failed request is retried
+	MaxRetries int
+	// RetryBaseDelay is the delay before the first retry, doubled on every following attempt
+	RetryBaseDelay time.Duration
+
 	Http http.Client
 }
 
 type ErrorResponse struct {
-	Message string
+	Message string              `json:"message"`
+	Errors  map[string][]string `json:"errors,omitempty"`
+}
+
+// APIError describes a failed response from the Laravel Vapor API.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Message    string
+	// Errors holds validation messages by field name, usually sent along with 422 responses
+	Errors map[string][]string
+}
+
+func (e *APIError) Error() string {
+	message := strconv.Itoa(e.StatusCode) + " " + e.Method + " request to " + e.URL + " failed with message: " + e.Message
+
+	if len(e.Errors) == 0 {
+		return message
+	}
+
+	fields := make([]string, 0, len(e.Errors))
+
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	details := []string{}
+
+	for _, field := range fields {
+		for _, fieldMessage := range e.Errors[field] {
+			details = append(details, field+": "+fieldMessage)
+		}
+	}
+
+	return message + " (" + strings.Join(details, "; ") + ")"
 }
 
-func prepareRequest[T interface{}](client *VaporClient, method string, path string, decode *T, body io.Reader) error {
+// sendRequest sends a request to the API, retrying rate limited and server failed responses.
+// No response is returned for mutating requests skipped in dry run mode, the caller must close the response body otherwise.
+func sendRequest(ctx context.Context, client *VaporClient, method string, path string, body io.Reader) (*http.Response, string, error) {
 	apiHost := client.apiHost
 
 	if apiHost == "" {
@@ -32,65 +96,523 @@ func prepareRequest[T interface{}](client *VaporClient, method string, path stri
 	baseUrl, err := url.Parse(apiHost)
 
 	if err != nil {
-		log.Fatal(err)
+		return nil, "", fmt.Errorf("invalid API host %q: %w", apiHost, err)
+	}
+
+	// Query strings must not be escaped as part of the path
+	endpoint, query, _ := strings.Cut(path, "?")
+
+	// Joined paths are cleaned, so slashes around the base path never end up doubled
+	requestUrl := baseUrl.JoinPath(client.apiBasePath, endpoint)
+	requestUrl.RawQuery = query
+
+	uri := requestUrl.String()
+
+	// Body is buffered so it can be sent again when retrying
+	var payload []byte
+
+	if body != nil {
+		payload, err = io.ReadAll(body)
+
+		if err != nil {
+			return nil, uri, err
+		}
+	}
+
+	if client.dryRun && method != "GET" {
+		tflog.Info(ctx, "Skipping Laravel Vapor API request in dry run mode", map[string]interface{}{
+			"method": method,
+			"url":    uri,
+			"body":   truncate(redactBody(payload), debugBodyLength),
+		})
+
+		return nil, uri, nil
+	}
+
+	// Creations keep the same key across retries, so a request applied before failing is not applied twice
+	var idempotencyKey string
+
+	if method == "POST" {
+		idempotencyKey, err = uuid.GenerateUUID()
+
+		if err != nil {
+			return nil, uri, fmt.Errorf("unable to generate idempotency key: %w", err)
+		}
+	}
+
+	var res *http.Response
+
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+
+		if payload != nil {
+			reqBody = bytes.NewReader(payload)
+		}
+
+		req, reqErr := http.NewRequestWithContext(ctx, method, uri, reqBody)
+
+		if reqErr != nil {
+			return nil, uri, reqErr
+		}
+
+		req.Header.Add("Authorization", "Bearer "+client.apiToken)
+		req.Header.Add("Accept", "application/json")
+		req.Header.Add("Content-Type", "application/json")
+
+		if idempotencyKey != "" {
+			req.Header.Add(idempotencyKeyHeader, idempotencyKey)
+		}
+
+		tflog.Debug(ctx, "Sending Laravel Vapor API request", map[string]interface{}{
+			"method":  method,
+			"url":     uri,
+			"attempt": attempt + 1,
+			"headers": redactHeaders(req.Header),
+			"body":    truncate(redactBody(payload), debugBodyLength),
+		})
+
+		var resErr error
+
+		res, resErr = client.Http.Do(req)
+
+		if resErr != nil {
+			return nil, uri, resErr
+		}
+
+		if !shouldRetry(res.StatusCode) || attempt >= client.MaxRetries {
+			break
+		}
+
+		delay := retryDelay(client.RetryBaseDelay, attempt)
+
+		// Rate limited responses might tell how long to wait
+		if res.StatusCode == http.StatusTooManyRequests {
+			if after, ok := parseRetryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+				delay = after
+			}
+		}
+
+		closeBody(res.Body)
+
+		if idempotencyKey != "" {
+			tflog.Warn(ctx, "Retrying a Laravel Vapor API create request, it might be applied twice if the API does not honor the idempotency key", map[string]interface{}{
+				"method":          method,
+				"url":             uri,
+				"status":          res.StatusCode,
+				"idempotency_key": idempotencyKey,
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, uri, ctx.Err()
+		case <-time.After(delay):
+		}
 	}
 
-	uri := baseUrl.JoinPath(path).String()
+	return res, uri, nil
+}
 
-	req, reqErr := http.NewRequest(method, uri, body)
+func prepareRequest[T interface{}](ctx context.Context, client *VaporClient, method string, path string, decode *T, body io.Reader) error {
+	res, uri, err := sendRequest(ctx, client, method, path, body)
 
-	if reqErr != nil {
-		return reqErr
+	// Nothing was sent in dry run mode
+	if err != nil || res == nil {
+		return err
 	}
 
-	req.Header.Add("Authorization", "Bearer "+client.apiToken)
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/json")
+	defer closeBody(res.Body)
 
-	res, resErr := client.Http.Do(req)
+	resBody, readErr := io.ReadAll(res.Body)
 
-	if resErr != nil {
-		return resErr
+	if readErr != nil {
+		return readErr
 	}
 
+	resBody = bytes.TrimSpace(resBody)
+
+	tflog.Debug(ctx, "Received Laravel Vapor API response", map[string]interface{}{
+		"method": method,
+		"url":    uri,
+		"status": res.StatusCode,
+		"body":   truncate(redactBody(resBody), debugBodyLength),
+	})
+
 	if res.StatusCode > 299 {
-		errorRes := ErrorResponse{}
+		return newAPIError(res.StatusCode, method, uri, resBody)
+	}
+
+	// Nothing to decode, e.g. on deletions
+	if len(resBody) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(resBody, &decode)
+}
+
+// newAPIError builds the error of a failed response from its body.
+func newAPIError(statusCode int, method string, uri string, resBody []byte) *APIError {
+	errorRes := ErrorResponse{}
+
+	decodeErr := json.Unmarshal(resBody, &errorRes)
+
+	// Gateways and proxies might respond with empty or HTML bodies
+	if errorRes.Message == "" {
+		errorRes.Message = http.StatusText(statusCode)
+
+		if decodeErr != nil && len(resBody) > 0 {
+			errorRes.Message += ": " + truncate(string(resBody), errorBodySnippetLength)
+		}
+	}
+
+	return &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		URL:        uri,
+		Message:    errorRes.Message,
+		Errors:     errorRes.Errors,
+	}
+}
+
+// redactHeaders returns the request headers with credentials hidden.
+func redactHeaders(headers http.Header) map[string]string {
+	redacted := map[string]string{}
+
+	for name := range headers {
+		redacted[name] = headers.Get(name)
+
+		if name == "Authorization" {
+			redacted[name] = redactedValue
+		}
+	}
+
+	return redacted
+}
+
+// redactBody returns a JSON body as text with the values of sensitive fields hidden.
+func redactBody(body []byte) string {
+	var decoded interface{}
+
+	if len(body) == 0 || json.Unmarshal(body, &decoded) != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(decoded))
+
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redacted)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range typed {
+			if sensitiveFields[strings.ToLower(field)] {
+				typed[field] = redactedValue
+			} else if sensitiveObjects[strings.ToLower(field)] {
+				typed[field] = redactAll(fieldValue)
+			} else {
+				typed[field] = redactValue(fieldValue)
+			}
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redactValue(item)
+		}
+	}
+
+	return value
+}
+
+// redactAll redacts every value, keeping the keys of objects so logs still tell which ones were sent.
+func redactAll(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range typed {
+			typed[field] = redactAll(fieldValue)
+		}
+
+		return typed
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redactAll(item)
+		}
+
+		return typed
+	}
+
+	return redactedValue
+}
+
+// waitForStatus polls the status until it is no longer one of the pending ones, or the context is done.
+func waitForStatus(ctx context.Context, interval time.Duration, pending []string, refresh func() (string, error)) (string, error) {
+	for {
+		status, err := refresh()
+
+		if err != nil {
+			return status, err
+		}
+
+		if !slices.Contains(pending, status) {
+			return status, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for status change", map[string]interface{}{"status": status})
+
+		select {
+		case <-ctx.Done():
+			return status, fmt.Errorf("timed out waiting while status is %q: %w", status, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// isNotFound reports whether the error is an API response for a missing resource.
+func isNotFound(err error) bool {
+	var apiErr *APIError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// isUnauthorized reports whether the error is an API response for a missing or invalid token.
+func isUnauthorized(err error) bool {
+	var apiErr *APIError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized
+}
+
+// shouldRetry reports whether a response status is rate limited or a server failure.
+func shouldRetry(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || (statusCode >= 500 && statusCode <= 599)
+}
+
+// retryDelay returns an exponential backoff for the given attempt with half of it jittered.
+func retryDelay(base time.Duration, attempt int) time.Duration {
+	delay := base << attempt
+
+	if delay <= 0 || delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	half := delay / 2
+
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter returns the delay from a Retry-After header in delta-seconds or HTTP-date form.
+func parseRetryAfter(header string, now time.Time) (time.Duration, bool) {
+	header = strings.TrimSpace(header)
+
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(header)
+
+	if err != nil {
+		return 0, false
+	}
+
+	if delay := date.Sub(now); delay > 0 {
+		return delay, true
+	}
+
+	return 0, true
+}
+
+// closeBody drains the remaining body so the connection can be reused, then closes it.
+func closeBody(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, body)
+	_ = body.Close()
+}
 
-		json.NewDecoder(res.Body).Decode(&errorRes)
+// truncate shortens a text to the given length of bytes, marking it as truncated.
+func truncate(text string, length int) string {
+	if len(text) <= length {
+		return text
+	}
+
+	return text[:length] + "..."
+}
+
+// paginatedResponse describes a paginated collection envelope.
+type paginatedResponse[T interface{}] struct {
+	Data  []T `json:"data"`
+	Links struct {
+		Next string `json:"next"`
+	} `json:"links"`
+	Meta struct {
+		CurrentPage int `json:"current_page"`
+		LastPage    int `json:"last_page"`
+	} `json:"meta"`
+}
+
+// prepareListRequest collects all items of a collection, following pages when the response is paginated.
+func prepareListRequest[T interface{}](ctx context.Context, client *VaporClient, path string) ([]T, error) {
+	items := []T{}
+
+	for path != "" {
+		raw := json.RawMessage{}
+
+		err := prepareRequest(ctx, client, "GET", path, &raw, nil)
+
+		if err != nil {
+			return items, err
+		}
+
+		// Non paginated collections are sent as bare arrays
+		if trimmed := bytes.TrimSpace(raw); len(trimmed) == 0 || trimmed[0] == '[' {
+			page := []T{}
+
+			if len(trimmed) > 0 {
+				err = json.Unmarshal(trimmed, &page)
+			}
+
+			return append(items, page...), err
+		}
+
+		page := paginatedResponse[T]{}
+
+		err = json.Unmarshal(raw, &page)
+
+		if err != nil {
+			return items, err
+		}
+
+		items = append(items, page.Data...)
+
+		path, err = nextPagePath(page.Links.Next)
+
+		if err != nil {
+			return items, err
+		}
+	}
+
+	return items, nil
+}
+
+// nextPagePath turns an absolute next page link into a request path.
+func nextPagePath(next string) (string, error) {
+	if next == "" {
+		return "", nil
+	}
+
+	nextUrl, err := url.Parse(next)
+
+	if err != nil {
+		return "", err
+	}
+
+	path := strings.TrimPrefix(nextUrl.Path, "/")
+
+	if nextUrl.RawQuery != "" {
+		path += "?" + nextUrl.RawQuery
+	}
+
+	return path, nil
+}
 
-		return errors.New(strconv.Itoa(res.StatusCode) + " " + method + " request to " + uri + " failed with message: " + errorRes.Message)
+// FlexInt decodes IDs sent either as a number or a numeric string, as the representation varies between endpoints.
+type FlexInt int
+
+func (value *FlexInt) UnmarshalJSON(data []byte) error {
+	var number int
+
+	if err := json.Unmarshal(data, &number); err == nil {
+		*value = FlexInt(number)
+		return nil
+	}
+
+	var text string
+
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("unexpected ID format %s: %w", data, err)
+	}
+
+	// Empty strings stand for a missing ID, like null does for numbers
+	if text == "" {
+		*value = 0
+		return nil
 	}
 
-	decodeErr := json.NewDecoder(res.Body).Decode(&decode)
+	number, err := strconv.Atoi(text)
 
-	// resBody, _ := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("unexpected ID format %s: %w", data, err)
+	}
 
-	// fmt.Print(resBody)
+	*value = FlexInt(number)
 
-	return decodeErr
+	return nil
 }
 
 type Account struct {
-	Id              int    `json:"id,omitempty"`
-	Name            string `json:"name,omitempty"`
-	Email           string `json:"email,omitempty"`
-	EmailVerifiedAt string `json:"email_verified_at,omitempty"`
-	AddressLineOne  string `json:"address_line_one,omitempty"`
-	Teams           []Team `json:"teams,omitempty"`
-	AvatarUrl       string `json:"avatar_url,omitempty"`
-	Sandboxed       bool   `json:"is_sandboxed,omitempty"`
+	Id              FlexInt `json:"id,omitempty"`
+	Name            string  `json:"name,omitempty"`
+	Email           string  `json:"email,omitempty"`
+	EmailVerifiedAt string  `json:"email_verified_at,omitempty"`
+	AddressLineOne  string  `json:"address_line_one,omitempty"`
+	Teams           []Team  `json:"teams,omitempty"`
+	OwnedTeams      []Team  `json:"owned_teams,omitempty"`
+	AvatarUrl       string  `json:"avatar_url,omitempty"`
+	Sandboxed       bool    `json:"is_sandboxed,omitempty"`
 }
 
-func (client *VaporClient) GetAccount() (*Account, error) {
+func (client *VaporClient) GetAccount(ctx context.Context) (*Account, error) {
 	account := Account{}
 
-	err := prepareRequest(client, "GET", "api/user", &account, nil)
+	err := prepareRequest(ctx, client, "GET", "api/user", &account, nil)
 
 	return &account, err
 }
 
+// Ping checks the API is reachable and the token is accepted, the authenticated account is the lightest endpoint requiring it.
+func (client *VaporClient) Ping(ctx context.Context) (*Account, error) {
+	return client.GetAccount(ctx)
+}
+
+type VaporToken struct {
+	Id        FlexInt `json:"id,omitempty"`
+	Name      string  `json:"name,omitempty"`
+	Token     string  `json:"token,omitempty"`
+	ExpiresAt string  `json:"expires_at,omitempty"`
+}
+
+func (client *VaporClient) CreateTeamToken(ctx context.Context, teamId int, name string, ttl int64) (*VaporToken, error) {
+	createdToken := VaporToken{}
+
+	val, _ := json.Marshal(struct {
+		Name string `json:"name"`
+		Ttl  int64  `json:"ttl"`
+	}{
+		Name: name,
+		Ttl:  ttl,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/tokens", &createdToken, bytes.NewBuffer(val))
+
+	return &createdToken, err
+}
+
+func (client *VaporClient) RemoveToken(ctx context.Context, tokenId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/tokens/"+strconv.Itoa(tokenId), &VaporToken{}, nil)
+
+	return err
+}
+
 type Team struct {
-	Id                       int     `json:"id,omitempty"`
+	Id                       FlexInt `json:"id,omitempty"`
 	Name                     string  `json:"name,omitempty"`
 	AwsId                    string  `json:"aws_external_id,omitempty"`
 	SentryOrganisationName   string  `json:"sentry_organization_name,omitempty"`
@@ -98,38 +620,67 @@ type Team struct {
 	Owner                    Account `json:"owner,omitempty"`
 }
 
-func (client *VaporClient) GetTeams() ([]Team, error) {
-	teams := []Team{}
+func (client *VaporClient) GetTeams(ctx context.Context) ([]Team, error) {
+	return prepareListRequest[Team](ctx, client, "api/teams")
+}
+
+func (client *VaporClient) GetTeam(ctx context.Context, teamId int) (*Team, error) {
+	team := Team{}
 
-	err := prepareRequest(client, "GET", "api/teams", &teams, nil)
+	err := prepareRequest(ctx, client, "GET", "api/teams/"+strconv.Itoa(teamId), &team, nil)
 
-	return teams, err
+	return &team, err
 }
 
-func (client *VaporClient) CreateTeam(team Team) (*Team, error) {
+func (client *VaporClient) CreateTeam(ctx context.Context, team Team) (*Team, error) {
 	createdTeam := Team{}
 
 	// Fixes the empty owner object sent to API even using omitempty
 	val, _ := json.Marshal(struct {
-		Name string `json:"name"`
+		Name                     string `json:"name"`
+		SentryOrganisationName   string `json:"sentry_organization_name,omitempty"`
+		SentryOrganisationRegion string `json:"sentry_organization_region,omitempty"`
 	}{
-		Name: team.Name,
+		Name:                     team.Name,
+		SentryOrganisationName:   team.SentryOrganisationName,
+		SentryOrganisationRegion: team.SentryOrganisationRegion,
 	})
 
-	err := prepareRequest(client, "POST", "api/owned-teams", &createdTeam, bytes.NewBuffer(val))
+	err := prepareRequest(ctx, client, "POST", "api/owned-teams", &createdTeam, bytes.NewBuffer(val))
 
 	return &createdTeam, err
 }
 
-func (client *VaporClient) GetTeamMembers(teamId int) ([]Account, error) {
-	members := []Account{}
+func (client *VaporClient) UpdateTeam(ctx context.Context, teamId int, updates Team) (*Team, error) {
+	updatedTeam := Team{}
+
+	// Sentry settings are always sent, empty ones remove them
+	val, _ := json.Marshal(struct {
+		Name                     string `json:"name"`
+		SentryOrganisationName   string `json:"sentry_organization_name"`
+		SentryOrganisationRegion string `json:"sentry_organization_region"`
+	}{
+		Name:                     updates.Name,
+		SentryOrganisationName:   updates.SentryOrganisationName,
+		SentryOrganisationRegion: updates.SentryOrganisationRegion,
+	})
+
+	err := prepareRequest(ctx, client, "PUT", "api/teams/"+strconv.Itoa(teamId), &updatedTeam, bytes.NewBuffer(val))
+
+	return &updatedTeam, err
+}
+
+func (client *VaporClient) RemoveTeam(ctx context.Context, teamId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/teams/"+strconv.Itoa(teamId), &Team{}, nil)
 
-	err := prepareRequest(client, "GET", "api/teams/"+strconv.Itoa(teamId)+"/members", &members, nil)
+	return err
+}
 
-	return members, err
+func (client *VaporClient) GetTeamMembers(ctx context.Context, teamId int) ([]Account, error) {
+	return prepareListRequest[Account](ctx, client, "api/teams/"+strconv.Itoa(teamId)+"/members")
 }
 
-func (client *VaporClient) AddTeamMember(teamId int, email string, permissions []string) (*Account, error) {
+func (client *VaporClient) AddTeamMember(ctx context.Context, teamId int, email string, permissions []string) (*Account, error) {
 	createdUser := Account{}
 
 	// Fixes the empty owner object sent to API even using omitempty
@@ -141,12 +692,33 @@ func (client *VaporClient) AddTeamMember(teamId int, email string, permissions [
 		Permissions: permissions,
 	})
 
-	err := prepareRequest(client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/members", &createdUser, bytes.NewBuffer(val))
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/members", &createdUser, bytes.NewBuffer(val))
 
 	return &createdUser, err
 }
 
-func (client *VaporClient) RemoveTeamMember(teamId int, email string) (*Account, error) {
+func (client *VaporClient) UpdateTeamMember(ctx context.Context, teamId int, email string, permissions []string) (*Account, error) {
+	updatedUser := Account{}
+
+	// Always send a list, an empty one removes all permissions but keeps the member
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	val, _ := json.Marshal(struct {
+		Email       string   `json:"email"`
+		Permissions []string `json:"permissions"`
+	}{
+		Email:       email,
+		Permissions: permissions,
+	})
+
+	err := prepareRequest(ctx, client, "PUT", "api/teams/"+strconv.Itoa(teamId)+"/members", &updatedUser, bytes.NewBuffer(val))
+
+	return &updatedUser, err
+}
+
+func (client *VaporClient) RemoveTeamMember(ctx context.Context, teamId int, email string) (*Account, error) {
 	createdUser := Account{}
 
 	// Fixes the empty owner object sent to API even using omitempty
@@ -156,25 +728,27 @@ func (client *VaporClient) RemoveTeamMember(teamId int, email string) (*Account,
 		Email: email,
 	})
 
-	err := prepareRequest(client, "DELETE", "api/teams/"+strconv.Itoa(teamId)+"/members", &createdUser, bytes.NewBuffer(val))
+	err := prepareRequest(ctx, client, "DELETE", "api/teams/"+strconv.Itoa(teamId)+"/members", &createdUser, bytes.NewBuffer(val))
 
 	return &createdUser, err
 }
 
 type VaporProvider struct {
-	Id                    int    `json:"id,omitempty"`
-	TeamId                int    `json:"team_id,omitempty"`
-	Type                  string `json:"type,omitempty"`
-	Name                  string `json:"name,omitempty"`
-	Uuid                  string `json:"uuid,omitempty"`
-	RoleArn               string `json:"role_arn,omitempty"`
-	RoleSync              bool   `json:"role_sync,omitempty"`
-	SnsTopicArn           string `json:"sns_topic_arn,omitempty"`
-	NetworkLimit          int    `json:"network_limit,omitempty"`
-	LastDeletedRestApiAt  string `json:"last_deleted_rest_api_at,omitempty"`
-	QueuedForDeletion     bool   `json:"queued_for_deletion,omitempty"`
-	Concurrency           int    `json:"concurrency,omitempty"`
-	UnreservedConcurrency int    `json:"unreserved_concurrency,omitempty"`
+	Id                   FlexInt `json:"id,omitempty"`
+	TeamId               FlexInt `json:"team_id,omitempty"`
+	Type                 string  `json:"type,omitempty"`
+	Name                 string  `json:"name,omitempty"`
+	Uuid                 string  `json:"uuid,omitempty"`
+	RoleArn              string  `json:"role_arn,omitempty"`
+	RoleSync             bool    `json:"role_sync,omitempty"`
+	SnsTopicArn          string  `json:"sns_topic_arn,omitempty"`
+	NetworkLimit         int     `json:"network_limit,omitempty"`
+	LastDeletedRestApiAt string  `json:"last_deleted_rest_api_at,omitempty"`
+	QueuedForDeletion    bool    `json:"queued_for_deletion,omitempty"`
+
+	// Concurrency limits are pointers as zero is a valid limit
+	Concurrency           *int `json:"concurrency,omitempty"`
+	UnreservedConcurrency *int `json:"unreserved_concurrency,omitempty"`
 }
 
 type VaporProviderMeta struct {
@@ -182,111 +756,1142 @@ type VaporProviderMeta struct {
 	Secret string `json:"secret"`
 }
 
-func (client *VaporClient) CreateProvider(teamId int, provider VaporProvider, key string, secret string) error {
+func (client *VaporClient) CreateProvider(ctx context.Context, teamId int, provider VaporProvider, key string, secret string) (*VaporProvider, error) {
+	createdProvider := VaporProvider{}
+
 	val, _ := json.Marshal(struct {
-		Type string            `json:"type"`
-		Name string            `json:"name"`
-		Meta VaporProviderMeta `json:"meta"`
+		Type         string            `json:"type"`
+		Name         string            `json:"name"`
+		NetworkLimit int               `json:"network_limit,omitempty"`
+		Meta         VaporProviderMeta `json:"meta"`
 	}{
-		Type: provider.Type,
-		Name: provider.Name,
+		Type:         provider.Type,
+		Name:         provider.Name,
+		NetworkLimit: provider.NetworkLimit,
 		Meta: VaporProviderMeta{
 			Key:    key,
 			Secret: secret,
 		},
 	})
 
-	err := prepareRequest(client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/providers", &VaporProvider{}, bytes.NewBuffer(val))
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/providers", &createdProvider, bytes.NewBuffer(val))
 
-	return err
+	return &createdProvider, err
 }
 
-func (client *VaporClient) GetProviders(teamId int) ([]VaporProvider, error) {
-	providers := []VaporProvider{}
-
-	err := prepareRequest(client, "GET", "api/teams/"+strconv.Itoa(teamId)+"/providers", &providers, nil)
-
-	return providers, err
+func (client *VaporClient) GetProviders(ctx context.Context, teamId int) ([]VaporProvider, error) {
+	return prepareListRequest[VaporProvider](ctx, client, "api/teams/"+strconv.Itoa(teamId)+"/providers")
 }
 
-func (client *VaporClient) RemoveProvider(providerId int) error {
-	err := prepareRequest(client, "DELETE", "api/providers/"+strconv.Itoa(providerId), &VaporProvider{}, nil)
+func (client *VaporClient) GetProvider(ctx context.Context, providerId int) (*VaporProvider, error) {
+	provider := VaporProvider{}
 
-	return err
-}
+	err := prepareRequest(ctx, client, "GET", "api/providers/"+strconv.Itoa(providerId), &provider, nil)
 
-type VaporZone struct {
-	Id                int           `json:"id,omitempty"`
-	TeamId            int           `json:"team_id,omitempty"`
-	CloudProviderId   int           `json:"cloud_provider_id,omitempty"`
-	ZoneId            string        `json:"zone_id,omitempty"`
-	Zone              string        `json:"zone,omitempty"`
-	Nameservers       []string      `json:"nameservers,omitempty"`
-	SesVerified       bool          `json:"ses_verified,omitempty"`
-	Imporing          bool          `json:"importing,omitempty"`
-	QueuedForDeletion int           `json:"queued_for_deletion,omitempty"`
-	RecordsCount      int           `json:"records_count,omitempty"`
-	CloudProvider     VaporProvider `json:"cloud_provider,omitempty"`
+	return &provider, err
 }
 
-func (client *VaporClient) GetZones(teamId int) ([]VaporZone, error) {
-	zones := []VaporZone{}
-
-	err := prepareRequest(client, "GET", "api/teams/"+strconv.Itoa(teamId)+"/zones", &zones, nil)
-
-	return zones, err
-}
+func (client *VaporClient) UpdateProvider(ctx context.Context, providerId int, updates VaporProvider) (*VaporProvider, error) {
+	updatedProvider := VaporProvider{}
 
-func (client *VaporClient) GetZone(zoneId int) (VaporZone, error) {
-	zone := VaporZone{}
+	val, _ := json.Marshal(struct {
+		Name                  string `json:"name"`
+		RoleSync              bool   `json:"role_sync"`
+		NetworkLimit          int    `json:"network_limit,omitempty"`
+		Concurrency           *int   `json:"concurrency,omitempty"`
+		UnreservedConcurrency *int   `json:"unreserved_concurrency,omitempty"`
+	}{
+		Name:                  updates.Name,
+		RoleSync:              updates.RoleSync,
+		NetworkLimit:          updates.NetworkLimit,
+		Concurrency:           updates.Concurrency,
+		UnreservedConcurrency: updates.UnreservedConcurrency,
+	})
 
-	err := prepareRequest(client, "GET", "api/zones/"+strconv.Itoa(zoneId), &zone, nil)
+	err := prepareRequest(ctx, client, "PUT", "api/providers/"+strconv.Itoa(providerId), &updatedProvider, bytes.NewBuffer(val))
 
-	return zone, err
+	return &updatedProvider, err
 }
 
-func (client *VaporClient) CreateZone(teamId int, providerId int, name string) (VaporZone, error) {
-	zone := VaporZone{}
-
+func (client *VaporClient) UpdateProviderCredentials(ctx context.Context, providerId int, key string, secret string) error {
 	val, _ := json.Marshal(struct {
-		CloudProviderId int    `json:"cloud_provider_id"`
-		Zone            string `json:"zone"`
+		Meta VaporProviderMeta `json:"meta"`
 	}{
-		CloudProviderId: providerId,
-		Zone:            name,
+		Meta: VaporProviderMeta{
+			Key:    key,
+			Secret: secret,
+		},
 	})
 
-	err := prepareRequest(client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/zones", &zone, bytes.NewBuffer(val))
+	err := prepareRequest(ctx, client, "PUT", "api/providers/"+strconv.Itoa(providerId), &VaporProvider{}, bytes.NewBuffer(val))
 
-	return zone, err
+	return err
 }
 
-func (client *VaporClient) RemoveZone(zoneId int) error {
-	err := prepareRequest(client, "DELETE", "api/zones/"+strconv.Itoa(zoneId), &VaporZone{}, nil)
+func (client *VaporClient) RemoveProvider(ctx context.Context, providerId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/providers/"+strconv.Itoa(providerId), &VaporProvider{}, nil)
 
 	return err
 }
 
-type VaporZoneRecord struct {
-	Id     int    `json:"id,omitempty"`
-	ZoneId int    `json:"zone_id,omitempty"`
-	Type   string `json:"type,omitempty"`
-	Name   string `json:"name,omitempty"`
-	Value  string `json:"value,omitempty"`
+type VaporZone struct {
+	Id                FlexInt              `json:"id,omitempty"`
+	TeamId            FlexInt              `json:"team_id,omitempty"`
+	CloudProviderId   FlexInt              `json:"cloud_provider_id,omitempty"`
+	ZoneId            string               `json:"zone_id,omitempty"`
+	Zone              string               `json:"zone,omitempty"`
+	Nameservers       VaporNameservers     `json:"nameservers,omitempty"`
+	SesVerified       bool                 `json:"ses_verified,omitempty"`
+	Importing         bool                 `json:"importing,omitempty"`
+	QueuedForDeletion int                  `json:"queued_for_deletion,omitempty"`
+	RecordsCount      int                  `json:"records_count,omitempty"`
+	CloudProvider     VaporProvider        `json:"cloud_provider,omitempty"`
+	SesRecords        []VaporZoneSesRecord `json:"ses_dns_records,omitempty"`
 }
 
-func (client *VaporClient) CreateZoneRecord(record VaporZoneRecord) (VaporZoneRecord, error) {
-	zoneRecord := VaporZoneRecord{}
+// VaporNameservers decodes zone nameservers sent either as a list, a keyed object or null.
+type VaporNameservers []string
 
-	val, _ := json.Marshal(record)
+func (nameservers *VaporNameservers) UnmarshalJSON(data []byte) error {
+	var list []string
 
-	err := prepareRequest(client, "POST", "api/zones/"+strconv.Itoa(record.ZoneId)+"/records", &zoneRecord, bytes.NewBuffer(val))
+	if err := json.Unmarshal(data, &list); err == nil {
+		*nameservers = list
+		return nil
+	}
 
-	return zoneRecord, err
-}
+	var keyed map[string]string
+
+	if err := json.Unmarshal(data, &keyed); err != nil {
+		return fmt.Errorf("unexpected nameservers format: %w", err)
+	}
 
-func (client *VaporClient) RemoveZoneRecord(record VaporZoneRecord) error {
-	err := prepareRequest(client, "DELETE", "api/zones/"+strconv.Itoa(record.ZoneId)+"/records?type="+record.Type+"&name="+record.Name+"&value="+record.Value, &VaporZone{}, nil)
+	keys := make([]string, 0, len(keyed))
 
-	return err
+	for key := range keyed {
+		keys = append(keys, key)
+	}
+
+	// Keep the order stable between reads
+	sort.Strings(keys)
+
+	list = make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		list = append(list, keyed[key])
+	}
+
+	*nameservers = list
+
+	return nil
+}
+
+type VaporZoneSesRecord struct {
+	Type  string `json:"type,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+func (client *VaporClient) GetZones(ctx context.Context, teamId int) ([]VaporZone, error) {
+	return prepareListRequest[VaporZone](ctx, client, "api/teams/"+strconv.Itoa(teamId)+"/zones")
+}
+
+func (client *VaporClient) GetZone(ctx context.Context, zoneId int) (VaporZone, error) {
+	zone := VaporZone{}
+
+	err := prepareRequest(ctx, client, "GET", "api/zones/"+strconv.Itoa(zoneId), &zone, nil)
+
+	return zone, err
+}
+
+// GetZoneSesRecords returns the DNS records SES still requires to verify the zone, empty once it is verified.
+func (client *VaporClient) GetZoneSesRecords(ctx context.Context, zoneId int) ([]VaporZoneSesRecord, error) {
+	zone, err := client.GetZone(ctx, zoneId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return zone.pendingSesRecords(), nil
+}
+
+// pendingSesRecords returns the SES records of the zone, only pending until SES verifies it.
+func (zone VaporZone) pendingSesRecords() []VaporZoneSesRecord {
+	if zone.SesVerified || zone.SesRecords == nil {
+		return []VaporZoneSesRecord{}
+	}
+
+	return zone.SesRecords
+}
+
+func (client *VaporClient) CreateZone(ctx context.Context, teamId int, providerId int, name string) (VaporZone, error) {
+	zone := VaporZone{}
+
+	val, _ := json.Marshal(struct {
+		CloudProviderId int    `json:"cloud_provider_id"`
+		Zone            string `json:"zone"`
+	}{
+		CloudProviderId: providerId,
+		Zone:            name,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/zones", &zone, bytes.NewBuffer(val))
+
+	return zone, err
+}
+
+func (client *VaporClient) RemoveZone(ctx context.Context, zoneId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/zones/"+strconv.Itoa(zoneId), &VaporZone{}, nil)
+
+	return err
+}
+
+type VaporZoneRecord struct {
+	Id     FlexInt `json:"id,omitempty"`
+	ZoneId FlexInt `json:"zone_id,omitempty"`
+	Type   string  `json:"type,omitempty"`
+	Name   string  `json:"name,omitempty"`
+	Value  string  `json:"value,omitempty"`
+	Ttl    int     `json:"ttl,omitempty"`
+
+	// Priority is only sent for MX records, zero is a valid priority
+	Priority *int `json:"priority,omitempty"`
+}
+
+func (client *VaporClient) GetZoneRecords(ctx context.Context, zoneId int) ([]VaporZoneRecord, error) {
+	return prepareListRequest[VaporZoneRecord](ctx, client, "api/zones/"+strconv.Itoa(zoneId)+"/records")
+}
+
+func (client *VaporClient) CreateZoneRecord(ctx context.Context, record VaporZoneRecord) (VaporZoneRecord, error) {
+	zoneRecord := VaporZoneRecord{}
+
+	val, _ := json.Marshal(record)
+
+	err := prepareRequest(ctx, client, "POST", "api/zones/"+strconv.Itoa(int(record.ZoneId))+"/records", &zoneRecord, bytes.NewBuffer(val))
+
+	return zoneRecord, err
+}
+
+func (client *VaporClient) UpdateZoneRecord(ctx context.Context, record VaporZoneRecord) (VaporZoneRecord, error) {
+	zoneRecord := VaporZoneRecord{}
+
+	val, _ := json.Marshal(struct {
+		Value    string `json:"value"`
+		Ttl      int    `json:"ttl,omitempty"`
+		Priority *int   `json:"priority,omitempty"`
+	}{
+		Value:    record.Value,
+		Ttl:      record.Ttl,
+		Priority: record.Priority,
+	})
+
+	err := prepareRequest(ctx, client, "PUT", "api/zones/"+strconv.Itoa(int(record.ZoneId))+"/records/"+strconv.Itoa(int(record.Id)), &zoneRecord, bytes.NewBuffer(val))
+
+	return zoneRecord, err
+}
+
+// CreateZoneRecords creates the records of a zone concurrently, as the API has no batch endpoint.
+// Results are returned by input index along with the errors, records that failed to be created are nil.
+func (client *VaporClient) CreateZoneRecords(ctx context.Context, zoneId int, records []VaporZoneRecord) ([]*VaporZoneRecord, error) {
+	created := make([]*VaporZoneRecord, len(records))
+	errs := make([]error, len(records))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < min(maxConcurrentRequests, len(records)); worker++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				record := records[i]
+				record.ZoneId = FlexInt(zoneId)
+
+				createdRecord, err := client.CreateZoneRecord(ctx, record)
+
+				if err != nil {
+					errs[i] = err
+					continue
+				}
+
+				created[i] = &createdRecord
+			}
+		}()
+	}
+
+	for i := range records {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return created, errors.Join(errs...)
+}
+
+func (client *VaporClient) RemoveZoneRecord(ctx context.Context, record VaporZoneRecord) error {
+	query := url.Values{}
+	query.Set("type", record.Type)
+	query.Set("name", record.Name)
+	query.Set("value", record.Value)
+
+	err := prepareRequest(ctx, client, "DELETE", "api/zones/"+strconv.Itoa(int(record.ZoneId))+"/records?"+query.Encode(), &VaporZone{}, nil)
+
+	return err
+}
+
+type VaporProject struct {
+	Id               FlexInt `json:"id,omitempty"`
+	TeamId           FlexInt `json:"team_id,omitempty"`
+	CloudProviderId  FlexInt `json:"cloud_provider_id,omitempty"`
+	Name             string  `json:"name,omitempty"`
+	Region           string  `json:"region,omitempty"`
+	GithubRepository string  `json:"github_repository,omitempty"`
+}
+
+func (client *VaporClient) GetProjects(ctx context.Context, teamId int) ([]VaporProject, error) {
+	return prepareListRequest[VaporProject](ctx, client, "api/teams/"+strconv.Itoa(teamId)+"/projects")
+}
+
+func (client *VaporClient) GetProject(ctx context.Context, projectId int) (*VaporProject, error) {
+	project := VaporProject{}
+
+	err := prepareRequest(ctx, client, "GET", "api/projects/"+strconv.Itoa(projectId), &project, nil)
+
+	return &project, err
+}
+
+func (client *VaporClient) CreateProject(ctx context.Context, teamId int, name string, providerId int, region string) (*VaporProject, error) {
+	createdProject := VaporProject{}
+
+	val, _ := json.Marshal(struct {
+		CloudProviderId int    `json:"cloud_provider_id"`
+		Name            string `json:"name"`
+		Region          string `json:"region"`
+	}{
+		CloudProviderId: providerId,
+		Name:            name,
+		Region:          region,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/projects", &createdProject, bytes.NewBuffer(val))
+
+	return &createdProject, err
+}
+
+func (client *VaporClient) UpdateProject(ctx context.Context, projectId int, name string) (*VaporProject, error) {
+	updatedProject := VaporProject{}
+
+	val, _ := json.Marshal(struct {
+		Name string `json:"name"`
+	}{
+		Name: name,
+	})
+
+	err := prepareRequest(ctx, client, "PUT", "api/projects/"+strconv.Itoa(projectId), &updatedProject, bytes.NewBuffer(val))
+
+	return &updatedProject, err
+}
+
+func (client *VaporClient) RemoveProject(ctx context.Context, projectId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/projects/"+strconv.Itoa(projectId), &VaporProject{}, nil)
+
+	return err
+}
+
+type VaporEnvironment struct {
+	Id        FlexInt `json:"id,omitempty"`
+	ProjectId FlexInt `json:"project_id,omitempty"`
+	Name      string  `json:"name,omitempty"`
+}
+
+func (client *VaporClient) GetEnvironments(ctx context.Context, projectId int) ([]VaporEnvironment, error) {
+	return prepareListRequest[VaporEnvironment](ctx, client, "api/projects/"+strconv.Itoa(projectId)+"/environments")
+}
+
+func (client *VaporClient) CreateEnvironment(ctx context.Context, projectId int, name string) (*VaporEnvironment, error) {
+	createdEnvironment := VaporEnvironment{}
+
+	val, _ := json.Marshal(struct {
+		Name string `json:"name"`
+	}{
+		Name: name,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/projects/"+strconv.Itoa(projectId)+"/environments", &createdEnvironment, bytes.NewBuffer(val))
+
+	return &createdEnvironment, err
+}
+
+func (client *VaporClient) RemoveEnvironment(ctx context.Context, projectId int, name string) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/projects/"+strconv.Itoa(projectId)+"/environments/"+url.PathEscape(name), &VaporEnvironment{}, nil)
+
+	return err
+}
+
+type VaporEnvironmentVariables struct {
+	Variables map[string]string `json:"variables"`
+}
+
+func (client *VaporClient) GetEnvironmentVariables(ctx context.Context, environmentId int) (map[string]string, error) {
+	environmentVariables := VaporEnvironmentVariables{}
+
+	err := prepareRequest(ctx, client, "GET", "api/environments/"+strconv.Itoa(environmentId)+"/variables", &environmentVariables, nil)
+
+	return environmentVariables.Variables, err
+}
+
+// UpdateEnvironmentVariables replaces the whole set of variables of an environment.
+func (client *VaporClient) UpdateEnvironmentVariables(ctx context.Context, environmentId int, variables map[string]string) error {
+	// Always send an object, an empty one removes all variables
+	if variables == nil {
+		variables = map[string]string{}
+	}
+
+	val, _ := json.Marshal(VaporEnvironmentVariables{
+		Variables: variables,
+	})
+
+	err := prepareRequest(ctx, client, "PUT", "api/environments/"+strconv.Itoa(environmentId)+"/variables", &VaporEnvironmentVariables{}, bytes.NewBuffer(val))
+
+	return err
+}
+
+type VaporSecret struct {
+	Id            FlexInt `json:"id,omitempty"`
+	EnvironmentId FlexInt `json:"environment_id,omitempty"`
+	Name          string  `json:"name,omitempty"`
+	Version       int     `json:"version,omitempty"`
+}
+
+func (client *VaporClient) GetSecrets(ctx context.Context, environmentId int) ([]VaporSecret, error) {
+	return prepareListRequest[VaporSecret](ctx, client, "api/environments/"+strconv.Itoa(environmentId)+"/secrets")
+}
+
+// CreateSecret stores a secret in an environment, replacing the value of an existing one with the same name.
+func (client *VaporClient) CreateSecret(ctx context.Context, environmentId int, name string, value string) (*VaporSecret, error) {
+	createdSecret := VaporSecret{}
+
+	val, _ := json.Marshal(struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}{
+		Name:  name,
+		Value: value,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/environments/"+strconv.Itoa(environmentId)+"/secrets", &createdSecret, bytes.NewBuffer(val))
+
+	return &createdSecret, err
+}
+
+func (client *VaporClient) RemoveSecret(ctx context.Context, secretId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/secrets/"+strconv.Itoa(secretId), &VaporSecret{}, nil)
+
+	return err
+}
+
+type VaporDeployment struct {
+	Id            FlexInt `json:"id,omitempty"`
+	ProjectId     FlexInt `json:"project_id,omitempty"`
+	EnvironmentId FlexInt `json:"environment_id,omitempty"`
+	Status        string  `json:"status,omitempty"`
+	CommitHash    string  `json:"commit_hash,omitempty"`
+	Url           string  `json:"url,omitempty"`
+	CreatedAt     string  `json:"created_at,omitempty"`
+}
+
+func (client *VaporClient) GetDeployments(ctx context.Context, environmentId int) ([]VaporDeployment, error) {
+	return prepareListRequest[VaporDeployment](ctx, client, "api/environments/"+strconv.Itoa(environmentId)+"/deployments")
+}
+
+func (client *VaporClient) GetDeployment(ctx context.Context, deploymentId int) (*VaporDeployment, error) {
+	deployment := VaporDeployment{}
+
+	err := prepareRequest(ctx, client, "GET", "api/deployments/"+strconv.Itoa(deploymentId), &deployment, nil)
+
+	return &deployment, err
+}
+
+func (client *VaporClient) TriggerDeployment(ctx context.Context, environmentId int, commit string) (*VaporDeployment, error) {
+	createdDeployment := VaporDeployment{}
+
+	val, _ := json.Marshal(struct {
+		Commit string `json:"commit,omitempty"`
+	}{
+		Commit: commit,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/environments/"+strconv.Itoa(environmentId)+"/deployments", &createdDeployment, bytes.NewBuffer(val))
+
+	return &createdDeployment, err
+}
+
+// RollbackEnvironment deploys the build of a previous deployment again, the rollback is a new deployment.
+func (client *VaporClient) RollbackEnvironment(ctx context.Context, environmentId int, deploymentId int) (*VaporDeployment, error) {
+	createdDeployment := VaporDeployment{}
+
+	val, _ := json.Marshal(struct {
+		DeploymentId int `json:"deployment_id"`
+	}{
+		DeploymentId: deploymentId,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/environments/"+strconv.Itoa(environmentId)+"/rollback", &createdDeployment, bytes.NewBuffer(val))
+
+	return &createdDeployment, err
+}
+
+// GetDeploymentLog returns the build and deployment output of a deployment.
+// The log is streamed and only its end is kept when it exceeds maxDeploymentLogSize, reporting it was truncated.
+func (client *VaporClient) GetDeploymentLog(ctx context.Context, deploymentId int) (string, bool, error) {
+	res, uri, err := sendRequest(ctx, client, "GET", "api/deployments/"+strconv.Itoa(deploymentId)+"/log", nil)
+
+	if err != nil || res == nil {
+		return "", false, err
+	}
+
+	defer closeBody(res.Body)
+
+	if res.StatusCode > 299 {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, debugBodyLength))
+
+		return "", false, newAPIError(res.StatusCode, "GET", uri, bytes.TrimSpace(resBody))
+	}
+
+	log := &tailBuffer{size: maxDeploymentLogSize}
+
+	if _, err := io.Copy(log, res.Body); err != nil {
+		return "", false, err
+	}
+
+	tflog.Debug(ctx, "Received Laravel Vapor API deployment log", map[string]interface{}{
+		"url":       uri,
+		"status":    res.StatusCode,
+		"truncated": log.Truncated(),
+	})
+
+	return log.String(), log.Truncated(), nil
+}
+
+// tailBuffer keeps the last bytes written to it, up to its size, so long streams use bounded memory.
+type tailBuffer struct {
+	size    int
+	data    []byte
+	written int64
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.written += int64(len(p))
+	b.data = append(b.data, p...)
+
+	// Dropping the head only once twice the size is buffered keeps writes cheap
+	if len(b.data) > 2*b.size {
+		b.data = append(b.data[:0], b.data[len(b.data)-b.size:]...)
+	}
+
+	return len(p), nil
+}
+
+// Truncated reports whether more bytes were written than are kept.
+func (b *tailBuffer) Truncated() bool {
+	return b.written > int64(b.size)
+}
+
+// String returns the kept bytes, a character cut in half at the start is dropped.
+func (b *tailBuffer) String() string {
+	data := b.data
+
+	if len(data) > b.size {
+		data = data[len(data)-b.size:]
+	}
+
+	// Only the continuation bytes left over from the cut are dropped, invalid bytes in the log are kept
+	if b.Truncated() {
+		for i := 0; i < utf8.UTFMax-1 && len(data) > 0 && !utf8.RuneStart(data[0]); i++ {
+			data = data[1:]
+		}
+	}
+
+	return string(data)
+}
+
+type VaporCommand struct {
+	Id            FlexInt `json:"id,omitempty"`
+	EnvironmentId FlexInt `json:"environment_id,omitempty"`
+	Command       string  `json:"command,omitempty"`
+	Status        string  `json:"status,omitempty"`
+	ExitCode      int     `json:"exit_code,omitempty"`
+	Output        string  `json:"output,omitempty"`
+}
+
+func (client *VaporClient) GetCommand(ctx context.Context, commandId int) (*VaporCommand, error) {
+	command := VaporCommand{}
+
+	err := prepareRequest(ctx, client, "GET", "api/commands/"+strconv.Itoa(commandId), &command, nil)
+
+	return &command, err
+}
+
+func (client *VaporClient) RunCommand(ctx context.Context, environmentId int, command string) (*VaporCommand, error) {
+	createdCommand := VaporCommand{}
+
+	val, _ := json.Marshal(struct {
+		Command string `json:"command"`
+	}{
+		Command: command,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/environments/"+strconv.Itoa(environmentId)+"/commands", &createdCommand, bytes.NewBuffer(val))
+
+	return &createdCommand, err
+}
+
+type VaporDatabase struct {
+	Id              FlexInt `json:"id,omitempty"`
+	TeamId          FlexInt `json:"team_id,omitempty"`
+	CloudProviderId FlexInt `json:"cloud_provider_id,omitempty"`
+	Name            string  `json:"name,omitempty"`
+	Type            string  `json:"type,omitempty"`
+	Region          string  `json:"region,omitempty"`
+	InstanceClass   string  `json:"instance_class,omitempty"`
+	Status          string  `json:"status,omitempty"`
+	Endpoint        string  `json:"endpoint,omitempty"`
+	Port            int     `json:"port,omitempty"`
+}
+
+func (client *VaporClient) GetDatabases(ctx context.Context, teamId int) ([]VaporDatabase, error) {
+	return prepareListRequest[VaporDatabase](ctx, client, "api/teams/"+strconv.Itoa(teamId)+"/databases")
+}
+
+func (client *VaporClient) GetDatabase(ctx context.Context, databaseId int) (*VaporDatabase, error) {
+	database := VaporDatabase{}
+
+	err := prepareRequest(ctx, client, "GET", "api/databases/"+strconv.Itoa(databaseId), &database, nil)
+
+	return &database, err
+}
+
+func (client *VaporClient) CreateDatabase(ctx context.Context, teamId int, database VaporDatabase) (*VaporDatabase, error) {
+	createdDatabase := VaporDatabase{}
+
+	val, _ := json.Marshal(struct {
+		CloudProviderId int    `json:"cloud_provider_id"`
+		Name            string `json:"name"`
+		Type            string `json:"type"`
+		Region          string `json:"region"`
+		InstanceClass   string `json:"instance_class"`
+	}{
+		CloudProviderId: int(database.CloudProviderId),
+		Name:            database.Name,
+		Type:            database.Type,
+		Region:          database.Region,
+		InstanceClass:   database.InstanceClass,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/databases", &createdDatabase, bytes.NewBuffer(val))
+
+	return &createdDatabase, err
+}
+
+// ScaleDatabase changes the instance class of a database, the RDS instance is modified in place.
+func (client *VaporClient) ScaleDatabase(ctx context.Context, databaseId int, instanceClass string) (*VaporDatabase, error) {
+	updatedDatabase := VaporDatabase{}
+
+	val, _ := json.Marshal(struct {
+		InstanceClass string `json:"instance_class"`
+	}{
+		InstanceClass: instanceClass,
+	})
+
+	err := prepareRequest(ctx, client, "PUT", "api/databases/"+strconv.Itoa(databaseId), &updatedDatabase, bytes.NewBuffer(val))
+
+	return &updatedDatabase, err
+}
+
+func (client *VaporClient) RemoveDatabase(ctx context.Context, databaseId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/databases/"+strconv.Itoa(databaseId), &VaporDatabase{}, nil)
+
+	return err
+}
+
+type VaporDatabaseUser struct {
+	Id         FlexInt `json:"id,omitempty"`
+	DatabaseId FlexInt `json:"database_id,omitempty"`
+	Username   string  `json:"username,omitempty"`
+	// Password is only returned when the user is created
+	Password string `json:"password,omitempty"`
+}
+
+func (client *VaporClient) GetDatabaseUsers(ctx context.Context, databaseId int) ([]VaporDatabaseUser, error) {
+	return prepareListRequest[VaporDatabaseUser](ctx, client, "api/databases/"+strconv.Itoa(databaseId)+"/users")
+}
+
+func (client *VaporClient) CreateDatabaseUser(ctx context.Context, databaseId int, username string) (*VaporDatabaseUser, error) {
+	createdUser := VaporDatabaseUser{}
+
+	val, _ := json.Marshal(struct {
+		Username string `json:"username"`
+	}{
+		Username: username,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/databases/"+strconv.Itoa(databaseId)+"/users", &createdUser, bytes.NewBuffer(val))
+
+	return &createdUser, err
+}
+
+func (client *VaporClient) RemoveDatabaseUser(ctx context.Context, databaseId int, userId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/databases/"+strconv.Itoa(databaseId)+"/users/"+strconv.Itoa(userId), &VaporDatabaseUser{}, nil)
+
+	return err
+}
+
+func (client *VaporClient) GetEnvironmentDatabases(ctx context.Context, environmentId int) ([]VaporDatabase, error) {
+	return prepareListRequest[VaporDatabase](ctx, client, "api/environments/"+strconv.Itoa(environmentId)+"/databases")
+}
+
+// AttachEnvironmentDatabase links a database to an environment, a database can be attached to many environments.
+func (client *VaporClient) AttachEnvironmentDatabase(ctx context.Context, environmentId int, databaseId int) error {
+	val, _ := json.Marshal(struct {
+		DatabaseId int `json:"database_id"`
+	}{
+		DatabaseId: databaseId,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/environments/"+strconv.Itoa(environmentId)+"/databases", &VaporDatabase{}, bytes.NewBuffer(val))
+
+	return err
+}
+
+func (client *VaporClient) DetachEnvironmentDatabase(ctx context.Context, environmentId int, databaseId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/environments/"+strconv.Itoa(environmentId)+"/databases/"+strconv.Itoa(databaseId), &VaporDatabase{}, nil)
+
+	return err
+}
+
+type VaporCache struct {
+	Id              FlexInt `json:"id,omitempty"`
+	TeamId          FlexInt `json:"team_id,omitempty"`
+	CloudProviderId FlexInt `json:"cloud_provider_id,omitempty"`
+	Name            string  `json:"name,omitempty"`
+	Type            string  `json:"type,omitempty"`
+	Region          string  `json:"region,omitempty"`
+	NodeType        string  `json:"instance_class,omitempty"`
+	Status          string  `json:"status,omitempty"`
+	Endpoint        string  `json:"endpoint,omitempty"`
+}
+
+func (client *VaporClient) GetCaches(ctx context.Context, teamId int) ([]VaporCache, error) {
+	return prepareListRequest[VaporCache](ctx, client, "api/teams/"+strconv.Itoa(teamId)+"/caches")
+}
+
+func (client *VaporClient) GetCache(ctx context.Context, cacheId int) (*VaporCache, error) {
+	cache := VaporCache{}
+
+	err := prepareRequest(ctx, client, "GET", "api/caches/"+strconv.Itoa(cacheId), &cache, nil)
+
+	return &cache, err
+}
+
+func (client *VaporClient) CreateCache(ctx context.Context, teamId int, cache VaporCache) (*VaporCache, error) {
+	createdCache := VaporCache{}
+
+	val, _ := json.Marshal(struct {
+		CloudProviderId int    `json:"cloud_provider_id"`
+		Name            string `json:"name"`
+		Type            string `json:"type"`
+		Region          string `json:"region"`
+		NodeType        string `json:"instance_class"`
+	}{
+		CloudProviderId: int(cache.CloudProviderId),
+		Name:            cache.Name,
+		Type:            cache.Type,
+		Region:          cache.Region,
+		NodeType:        cache.NodeType,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/caches", &createdCache, bytes.NewBuffer(val))
+
+	return &createdCache, err
+}
+
+// UpdateCache scales the nodes of a cache cluster.
+func (client *VaporClient) UpdateCache(ctx context.Context, cacheId int, nodeType string) (*VaporCache, error) {
+	updatedCache := VaporCache{}
+
+	val, _ := json.Marshal(struct {
+		NodeType string `json:"instance_class"`
+	}{
+		NodeType: nodeType,
+	})
+
+	err := prepareRequest(ctx, client, "PUT", "api/caches/"+strconv.Itoa(cacheId)+"/size", &updatedCache, bytes.NewBuffer(val))
+
+	return &updatedCache, err
+}
+
+func (client *VaporClient) RemoveCache(ctx context.Context, cacheId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/caches/"+strconv.Itoa(cacheId), &VaporCache{}, nil)
+
+	return err
+}
+
+func (client *VaporClient) GetEnvironmentCaches(ctx context.Context, environmentId int) ([]VaporCache, error) {
+	return prepareListRequest[VaporCache](ctx, client, "api/environments/"+strconv.Itoa(environmentId)+"/caches")
+}
+
+// AttachEnvironmentCache links a cache to an environment, a cache can be attached to many environments.
+func (client *VaporClient) AttachEnvironmentCache(ctx context.Context, environmentId int, cacheId int) error {
+	val, _ := json.Marshal(struct {
+		CacheId int `json:"cache_id"`
+	}{
+		CacheId: cacheId,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/environments/"+strconv.Itoa(environmentId)+"/caches", &VaporCache{}, bytes.NewBuffer(val))
+
+	return err
+}
+
+func (client *VaporClient) DetachEnvironmentCache(ctx context.Context, environmentId int, cacheId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/environments/"+strconv.Itoa(environmentId)+"/caches/"+strconv.Itoa(cacheId), &VaporCache{}, nil)
+
+	return err
+}
+
+type VaporCertificate struct {
+	Id                FlexInt                            `json:"id,omitempty"`
+	TeamId            FlexInt                            `json:"team_id,omitempty"`
+	Domain            string                             `json:"domain,omitempty"`
+	AlternativeNames  []string                           `json:"alternative_names,omitempty"`
+	Status            string                             `json:"status,omitempty"`
+	ValidationRecords []VaporCertificateValidationRecord `json:"dns_validation_records,omitempty"`
+}
+
+type VaporCertificateValidationRecord struct {
+	Type  string `json:"type,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+func (client *VaporClient) GetCertificate(ctx context.Context, certificateId int) (*VaporCertificate, error) {
+	certificate := VaporCertificate{}
+
+	err := prepareRequest(ctx, client, "GET", "api/certificates/"+strconv.Itoa(certificateId), &certificate, nil)
+
+	return &certificate, err
+}
+
+func (client *VaporClient) CreateCertificate(ctx context.Context, teamId int, domain string, alternativeNames []string) (*VaporCertificate, error) {
+	createdCertificate := VaporCertificate{}
+
+	if alternativeNames == nil {
+		alternativeNames = []string{}
+	}
+
+	val, _ := json.Marshal(struct {
+		Domain           string   `json:"domain"`
+		AlternativeNames []string `json:"alternative_names"`
+	}{
+		Domain:           domain,
+		AlternativeNames: alternativeNames,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/certificates", &createdCertificate, bytes.NewBuffer(val))
+
+	return &createdCertificate, err
+}
+
+func (client *VaporClient) RemoveCertificate(ctx context.Context, certificateId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/certificates/"+strconv.Itoa(certificateId), &VaporCertificate{}, nil)
+
+	return err
+}
+
+type VaporDomain struct {
+	Id            FlexInt `json:"id,omitempty"`
+	EnvironmentId FlexInt `json:"environment_id,omitempty"`
+	Domain        string  `json:"domain,omitempty"`
+	Status        string  `json:"status,omitempty"`
+}
+
+func (client *VaporClient) GetDomains(ctx context.Context, environmentId int) ([]VaporDomain, error) {
+	return prepareListRequest[VaporDomain](ctx, client, "api/environments/"+strconv.Itoa(environmentId)+"/domains")
+}
+
+func (client *VaporClient) CreateDomain(ctx context.Context, environmentId int, domain string) (*VaporDomain, error) {
+	createdDomain := VaporDomain{}
+
+	val, _ := json.Marshal(struct {
+		Domain string `json:"domain"`
+	}{
+		Domain: domain,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/environments/"+strconv.Itoa(environmentId)+"/domains", &createdDomain, bytes.NewBuffer(val))
+
+	return &createdDomain, err
+}
+
+func (client *VaporClient) RemoveDomain(ctx context.Context, domainId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/domains/"+strconv.Itoa(domainId), &VaporDomain{}, nil)
+
+	return err
+}
+
+type VaporJumpbox struct {
+	Id           FlexInt `json:"id,omitempty"`
+	NetworkId    FlexInt `json:"network_id,omitempty"`
+	Name         string  `json:"name,omitempty"`
+	InstanceType string  `json:"instance_type,omitempty"`
+	Status       string  `json:"status,omitempty"`
+	PublicIp     string  `json:"public_ip,omitempty"`
+}
+
+func (client *VaporClient) GetJumpbox(ctx context.Context, jumpboxId int) (*VaporJumpbox, error) {
+	jumpbox := VaporJumpbox{}
+
+	err := prepareRequest(ctx, client, "GET", "api/jumpboxes/"+strconv.Itoa(jumpboxId), &jumpbox, nil)
+
+	return &jumpbox, err
+}
+
+func (client *VaporClient) CreateJumpbox(ctx context.Context, networkId int, name string, instanceType string) (*VaporJumpbox, error) {
+	createdJumpbox := VaporJumpbox{}
+
+	val, _ := json.Marshal(struct {
+		Name         string `json:"name"`
+		InstanceType string `json:"instance_type"`
+	}{
+		Name:         name,
+		InstanceType: instanceType,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/networks/"+strconv.Itoa(networkId)+"/jumpboxes", &createdJumpbox, bytes.NewBuffer(val))
+
+	return &createdJumpbox, err
+}
+
+func (client *VaporClient) RemoveJumpbox(ctx context.Context, jumpboxId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/jumpboxes/"+strconv.Itoa(jumpboxId), &VaporJumpbox{}, nil)
+
+	return err
+}
+
+type VaporNetwork struct {
+	Id              FlexInt `json:"id,omitempty"`
+	TeamId          FlexInt `json:"team_id,omitempty"`
+	CloudProviderId FlexInt `json:"cloud_provider_id,omitempty"`
+	Name            string  `json:"name,omitempty"`
+	Region          string  `json:"region,omitempty"`
+	VpcId           string  `json:"vpc_id,omitempty"`
+	HasNatGateway   bool    `json:"has_internet_access,omitempty"`
+	Status          string  `json:"status,omitempty"`
+}
+
+func (client *VaporClient) GetNetwork(ctx context.Context, networkId int) (*VaporNetwork, error) {
+	network := VaporNetwork{}
+
+	err := prepareRequest(ctx, client, "GET", "api/networks/"+strconv.Itoa(networkId), &network, nil)
+
+	return &network, err
+}
+
+func (client *VaporClient) CreateNetwork(ctx context.Context, teamId int, network VaporNetwork) (*VaporNetwork, error) {
+	createdNetwork := VaporNetwork{}
+
+	val, _ := json.Marshal(struct {
+		CloudProviderId int    `json:"cloud_provider_id"`
+		Name            string `json:"name"`
+		Region          string `json:"region"`
+		WithNatGateway  bool   `json:"with_internet_access"`
+	}{
+		CloudProviderId: int(network.CloudProviderId),
+		Name:            network.Name,
+		Region:          network.Region,
+		WithNatGateway:  network.HasNatGateway,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/networks", &createdNetwork, bytes.NewBuffer(val))
+
+	return &createdNetwork, err
+}
+
+func (client *VaporClient) RemoveNetwork(ctx context.Context, networkId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/networks/"+strconv.Itoa(networkId), &VaporNetwork{}, nil)
+
+	return err
+}
+
+type VaporBalancer struct {
+	Id              FlexInt `json:"id,omitempty"`
+	TeamId          FlexInt `json:"team_id,omitempty"`
+	CloudProviderId FlexInt `json:"cloud_provider_id,omitempty"`
+	Name            string  `json:"name,omitempty"`
+	Region          string  `json:"region,omitempty"`
+	Status          string  `json:"status,omitempty"`
+}
+
+func (client *VaporClient) GetBalancers(ctx context.Context, teamId int) ([]VaporBalancer, error) {
+	return prepareListRequest[VaporBalancer](ctx, client, "api/teams/"+strconv.Itoa(teamId)+"/balancers")
+}
+
+type VaporMetrics struct {
+	Invocations     int64   `json:"invocations"`
+	Errors          int64   `json:"errors"`
+	AverageDuration float64 `json:"average_duration"`
+	MaximumDuration float64 `json:"maximum_duration"`
+}
+
+func (client *VaporClient) GetEnvironmentMetrics(ctx context.Context, environmentId int, period string) (*VaporMetrics, error) {
+	metrics := VaporMetrics{}
+
+	query := url.Values{}
+	query.Set("period", period)
+
+	err := prepareRequest(ctx, client, "GET", "api/environments/"+strconv.Itoa(environmentId)+"/metrics?"+query.Encode(), &metrics, nil)
+
+	return &metrics, err
+}
+
+type VaporAlarm struct {
+	Id                 FlexInt `json:"id,omitempty"`
+	TeamId             FlexInt `json:"team_id,omitempty"`
+	Metric             string  `json:"metric,omitempty"`
+	Operator           string  `json:"operator,omitempty"`
+	Threshold          float64 `json:"threshold"`
+	Period             int     `json:"period,omitempty"`
+	NotificationTarget string  `json:"notification_target,omitempty"`
+	Status             string  `json:"status,omitempty"`
+}
+
+// alarmPayload returns the configurable attributes of an alarm, as sent on creation and updates.
+func alarmPayload(alarm VaporAlarm) []byte {
+	val, _ := json.Marshal(struct {
+		Metric             string  `json:"metric"`
+		Operator           string  `json:"operator"`
+		Threshold          float64 `json:"threshold"`
+		Period             int     `json:"period"`
+		NotificationTarget string  `json:"notification_target"`
+	}{
+		Metric:             alarm.Metric,
+		Operator:           alarm.Operator,
+		Threshold:          alarm.Threshold,
+		Period:             alarm.Period,
+		NotificationTarget: alarm.NotificationTarget,
+	})
+
+	return val
+}
+
+func (client *VaporClient) GetAlarms(ctx context.Context, teamId int) ([]VaporAlarm, error) {
+	return prepareListRequest[VaporAlarm](ctx, client, "api/teams/"+strconv.Itoa(teamId)+"/alarms")
+}
+
+func (client *VaporClient) GetAlarm(ctx context.Context, alarmId int) (*VaporAlarm, error) {
+	alarm := VaporAlarm{}
+
+	err := prepareRequest(ctx, client, "GET", "api/alarms/"+strconv.Itoa(alarmId), &alarm, nil)
+
+	return &alarm, err
+}
+
+func (client *VaporClient) CreateAlarm(ctx context.Context, teamId int, alarm VaporAlarm) (*VaporAlarm, error) {
+	createdAlarm := VaporAlarm{}
+
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/alarms", &createdAlarm, bytes.NewBuffer(alarmPayload(alarm)))
+
+	return &createdAlarm, err
+}
+
+func (client *VaporClient) UpdateAlarm(ctx context.Context, alarmId int, alarm VaporAlarm) (*VaporAlarm, error) {
+	updatedAlarm := VaporAlarm{}
+
+	err := prepareRequest(ctx, client, "PUT", "api/alarms/"+strconv.Itoa(alarmId), &updatedAlarm, bytes.NewBuffer(alarmPayload(alarm)))
+
+	return &updatedAlarm, err
+}
+
+func (client *VaporClient) RemoveAlarm(ctx context.Context, alarmId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/alarms/"+strconv.Itoa(alarmId), &VaporAlarm{}, nil)
+
+	return err
+}
+
+type VaporNotification struct {
+	Id          FlexInt  `json:"id,omitempty"`
+	TeamId      FlexInt  `json:"team_id,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Destination string   `json:"destination,omitempty"`
+	Events      []string `json:"events,omitempty"`
+}
+
+func (client *VaporClient) GetNotification(ctx context.Context, notificationId int) (*VaporNotification, error) {
+	notification := VaporNotification{}
+
+	err := prepareRequest(ctx, client, "GET", "api/notifications/"+strconv.Itoa(notificationId), &notification, nil)
+
+	return &notification, err
+}
+
+func (client *VaporClient) CreateNotification(ctx context.Context, teamId int, notification VaporNotification) (*VaporNotification, error) {
+	createdNotification := VaporNotification{}
+
+	val, _ := json.Marshal(struct {
+		Type        string   `json:"type"`
+		Destination string   `json:"destination"`
+		Events      []string `json:"events"`
+	}{
+		Type:        notification.Type,
+		Destination: notification.Destination,
+		Events:      notification.Events,
+	})
+
+	err := prepareRequest(ctx, client, "POST", "api/teams/"+strconv.Itoa(teamId)+"/notifications", &createdNotification, bytes.NewBuffer(val))
+
+	return &createdNotification, err
+}
+
+// UpdateNotification changes the destination and subscribed events of a notification, its type cannot be changed.
+func (client *VaporClient) UpdateNotification(ctx context.Context, notificationId int, notification VaporNotification) (*VaporNotification, error) {
+	updatedNotification := VaporNotification{}
+
+	val, _ := json.Marshal(struct {
+		Destination string   `json:"destination"`
+		Events      []string `json:"events"`
+	}{
+		Destination: notification.Destination,
+		Events:      notification.Events,
+	})
+
+	err := prepareRequest(ctx, client, "PUT", "api/notifications/"+strconv.Itoa(notificationId), &updatedNotification, bytes.NewBuffer(val))
+
+	return &updatedNotification, err
+}
+
+func (client *VaporClient) RemoveNotification(ctx context.Context, notificationId int) error {
+	err := prepareRequest(ctx, client, "DELETE", "api/notifications/"+strconv.Itoa(notificationId), &VaporNotification{}, nil)
+
+	return err
+}
+
+// VaporQueueMetrics holds queued job counts, they are null while an environment has no queue configured.
+type VaporQueueMetrics struct {
+	Processed *int64 `json:"processed"`
+	Failed    *int64 `json:"failed"`
+	Pending   *int64 `json:"pending"`
+}
+
+func (client *VaporClient) GetQueueMetrics(ctx context.Context, environmentId int, period string) (*VaporQueueMetrics, error) {
+	metrics := VaporQueueMetrics{}
+
+	query := url.Values{}
+	query.Set("period", period)
+
+	err := prepareRequest(ctx, client, "GET", "api/environments/"+strconv.Itoa(environmentId)+"/queue-metrics?"+query.Encode(), &metrics, nil)
+
+	return &metrics, err
 }
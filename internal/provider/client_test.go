@@ -0,0 +1,1464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPrepareRequestRetriesServerErrors(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 19870}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{
+		apiHost:        server.URL,
+		MaxRetries:     3,
+		RetryBaseDelay: time.Millisecond,
+	}
+
+	account, err := client.GetAccount(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+
+	if account.Id != 19870 {
+		t.Errorf("expected account id 19870, got %d", account.Id)
+	}
+}
+
+func TestPrepareRequestReusesIdempotencyKeyOnRetries(t *testing.T) {
+	var keys []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+
+		if len(keys) < 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 3, "name": "Acme"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{
+		apiHost:        server.URL,
+		MaxRetries:     3,
+		RetryBaseDelay: time.Millisecond,
+	}
+
+	if _, err := client.CreateTeam(context.Background(), Team{Name: "Acme"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(keys) != 2 || keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("expected the same idempotency key on every attempt, got %q", keys)
+	}
+
+	if _, err := client.CreateTeam(context.Background(), Team{Name: "Acme"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if keys[2] == keys[0] {
+		t.Error("expected a new idempotency key for another create request")
+	}
+
+	if _, err := client.GetTeam(context.Background(), 3); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if keys[3] != "" {
+		t.Errorf("expected no idempotency key on reads, got %q", keys[3])
+	}
+}
+
+func TestPrepareRequestDryRun(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		_, _ = w.Write([]byte(`{"id": 3, "name": "Acme"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL, dryRun: true}
+
+	if _, err := client.CreateTeam(context.Background(), Team{Name: "Acme"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := client.UpdateTeam(context.Background(), 3, Team{Name: "Renamed"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := client.RemoveTeam(context.Background(), 3); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	team, err := client.GetTeam(context.Background(), 3)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !slices.Equal(requests, []string{"GET /api/teams/3"}) || team.Name != "Acme" {
+		t.Errorf("expected only the read to be sent, got %q", requests)
+	}
+}
+
+func TestPrepareRequestApiBasePath(t *testing.T) {
+	var requested string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+
+		_, _ = w.Write([]byte(`{"id": 19870}`))
+	}))
+	defer server.Close()
+
+	tests := map[string]struct {
+		host     string
+		basePath string
+		expected string
+	}{
+		"none":            {host: server.URL, basePath: "", expected: "/api/user"},
+		"relative":        {host: server.URL, basePath: "vapor", expected: "/vapor/api/user"},
+		"slashes":         {host: server.URL + "/", basePath: "/vapor/v2/", expected: "/vapor/v2/api/user"},
+		"after host path": {host: server.URL + "/proxy/", basePath: "/vapor", expected: "/proxy/vapor/api/user"},
+		"host path only":  {host: server.URL + "/proxy", basePath: "", expected: "/proxy/api/user"},
+		"doubled slashes": {host: server.URL, basePath: "//vapor//", expected: "/vapor/api/user"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := VaporClient{apiHost: test.host, apiBasePath: test.basePath}
+
+			if _, err := client.GetAccount(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if requested != test.expected {
+				t.Errorf("expected request to %q, got %q", test.expected, requested)
+			}
+		})
+	}
+}
+
+func TestPrepareRequestDoesNotRetryClientErrors(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := VaporClient{
+		apiHost:        server.URL,
+		MaxRetries:     3,
+		RetryBaseDelay: time.Millisecond,
+	}
+
+	_, err := client.GetAccount(context.Background())
+
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestPrepareRequestGivesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := VaporClient{
+		apiHost:        server.URL,
+		MaxRetries:     2,
+		RetryBaseDelay: time.Millisecond,
+	}
+
+	_, err := client.GetAccount(context.Background())
+
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	for attempt := 0; attempt < 4; attempt++ {
+		delay := retryDelay(time.Second, attempt)
+		upper := time.Second << attempt
+
+		if delay < upper/2 || delay > upper {
+			t.Errorf("attempt %d: expected delay between %s and %s, got %s", attempt, upper/2, upper, delay)
+		}
+	}
+
+	if delay := retryDelay(time.Second, 20); delay > maxRetryDelay {
+		t.Errorf("expected delay capped at %s, got %s", maxRetryDelay, delay)
+	}
+}
+
+func TestPrepareRequestHonorsRetryAfter(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id": 19870}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{
+		apiHost:        server.URL,
+		MaxRetries:     3,
+		RetryBaseDelay: time.Millisecond,
+	}
+
+	start := time.Now()
+
+	_, err := client.GetAccount(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+
+	if elapsed := time.Since(start); elapsed < 2*time.Second {
+		t.Errorf("expected to wait at least 2s before retrying, waited %s", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, time.January, 21, 20, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		header   string
+		expected time.Duration
+		ok       bool
+	}{
+		"empty":         {header: "", ok: false},
+		"delta-seconds": {header: "2", expected: 2 * time.Second, ok: true},
+		"negative":      {header: "-1", ok: false},
+		"http-date":     {header: now.Add(5 * time.Second).Format(http.TimeFormat), expected: 5 * time.Second, ok: true},
+		"past-date":     {header: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0, ok: true},
+		"invalid":       {header: "soon", ok: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			delay, ok := parseRetryAfter(test.header, now)
+
+			if ok != test.ok {
+				t.Fatalf("expected ok %t, got %t", test.ok, ok)
+			}
+
+			if delay != test.expected {
+				t.Errorf("expected delay %s, got %s", test.expected, delay)
+			}
+		})
+	}
+}
+
+func TestPrepareRequestReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "This action is unauthorized."}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	_, err := client.GetAccount(context.Background())
+
+	var apiErr *APIError
+
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("expected status code %d, got %d", http.StatusForbidden, apiErr.StatusCode)
+	}
+
+	if apiErr.Method != "GET" || apiErr.URL != server.URL+"/api/user" {
+		t.Errorf("unexpected request %s %s", apiErr.Method, apiErr.URL)
+	}
+
+	if apiErr.Message != "This action is unauthorized." {
+		t.Errorf("unexpected message %q", apiErr.Message)
+	}
+}
+
+func TestPrepareRequestFormatsValidationErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{
+			"message": "The given data was invalid.",
+			"errors": {
+				"zone": ["The zone format is invalid."],
+				"cloud_provider_id": ["The selected cloud provider id is invalid."]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	_, err := client.CreateZone(context.Background(), 1, 2, "invalid domain")
+
+	var apiErr *APIError
+
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got %T", err)
+	}
+
+	if len(apiErr.Errors) != 2 {
+		t.Errorf("expected 2 field errors, got %d", len(apiErr.Errors))
+	}
+
+	expected := "422 POST request to " + server.URL + "/api/teams/1/zones failed with message: The given data was invalid. " +
+		"(cloud_provider_id: The selected cloud provider id is invalid.; zone: The zone format is invalid.)"
+
+	if err.Error() != expected {
+		t.Errorf("unexpected error message:\n%s", err)
+	}
+}
+
+func TestGetZonesFollowsPagination(t *testing.T) {
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/teams/1/zones" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"data": [{"id": 3, "zone": "example.org"}], "links": {"next": null}, "meta": {"current_page": 2, "last_page": 2}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data": [{"id": 1, "zone": "example.com"}, {"id": 2, "zone": "example.net"}], "links": {"next": "` + server.URL + `/api/teams/1/zones?page=2"}, "meta": {"current_page": 1, "last_page": 2}}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	zones, err := client.GetZones(context.Background(), 1)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(zones) != 3 {
+		t.Fatalf("expected 3 zones, got %d", len(zones))
+	}
+
+	if zones[2].Zone != "example.org" {
+		t.Errorf("expected last zone to be example.org, got %s", zones[2].Zone)
+	}
+}
+
+func TestGetZonesWithoutPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id": 1, "zone": "example.com"}, {"id": 2, "zone": "example.net"}]`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	zones, err := client.GetZones(context.Background(), 1)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(zones) != 2 {
+		t.Fatalf("expected 2 zones, got %d", len(zones))
+	}
+}
+
+func TestPrepareRequestHandlesNonJSONErrorBodies(t *testing.T) {
+	tests := map[string]struct {
+		status   int
+		body     string
+		expected string
+	}{
+		"empty": {
+			status:   http.StatusBadGateway,
+			body:     "",
+			expected: "Bad Gateway",
+		},
+		"html": {
+			status:   http.StatusBadGateway,
+			body:     "<html><body>502 Bad Gateway</body></html>",
+			expected: "Bad Gateway: <html><body>502 Bad Gateway</body></html>",
+		},
+		"json without message": {
+			status:   http.StatusInternalServerError,
+			body:     `{}`,
+			expected: "Internal Server Error",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := VaporClient{apiHost: server.URL}
+
+			_, err := client.GetAccount(context.Background())
+
+			var apiErr *APIError
+
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected an APIError, got %T", err)
+			}
+
+			if apiErr.Message != test.expected {
+				t.Errorf("expected message %q, got %q", test.expected, apiErr.Message)
+			}
+		})
+	}
+}
+
+// trackedBody records whether a response body was fully read and closed.
+type trackedBody struct {
+	io.Reader
+	closed  bool
+	drained bool
+}
+
+func (b *trackedBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+
+	if err == io.EOF {
+		b.drained = true
+	}
+
+	return n, err
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+
+	return nil
+}
+
+// roundTripFunc allows to stub HTTP responses without a server.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestPrepareRequestClosesResponseBodies(t *testing.T) {
+	bodies := []*trackedBody{}
+	statuses := []int{http.StatusServiceUnavailable, http.StatusOK}
+
+	client := VaporClient{
+		MaxRetries:     1,
+		RetryBaseDelay: time.Millisecond,
+		Http: http.Client{
+			Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				body := &trackedBody{Reader: strings.NewReader(`{"id": 19870} `)}
+				bodies = append(bodies, body)
+
+				return &http.Response{
+					StatusCode: statuses[len(bodies)-1],
+					Header:     http.Header{},
+					Body:       body,
+				}, nil
+			}),
+		},
+	}
+
+	_, err := client.GetAccount(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(bodies) != 2 {
+		t.Fatalf("expected 2 responses, got %d", len(bodies))
+	}
+
+	for i, body := range bodies {
+		if !body.drained || !body.closed {
+			t.Errorf("response %d: expected body to be drained and closed, got drained %t and closed %t", i, body.drained, body.closed)
+		}
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	body := []byte(`{"type":"aws","name":"production","meta":{"key":"AKIAEXAMPLE","secret":"super-secret"},"token":"abc","value":"db-password","destination":"https://hooks.slack.com/services/T000/B000/XXXX"}`)
+
+	redacted := redactBody(body)
+
+	for _, sensitive := range []string{"AKIAEXAMPLE", "super-secret", "abc", "db-password", "hooks.slack.com"} {
+		if strings.Contains(redacted, sensitive) {
+			t.Errorf("expected %q to be redacted from %s", sensitive, redacted)
+		}
+	}
+
+	if !strings.Contains(redacted, `"name":"production"`) {
+		t.Errorf("expected non sensitive fields to be kept, got %s", redacted)
+	}
+
+	if redactBody([]byte("<html></html>")) != "<html></html>" {
+		t.Error("expected non JSON bodies to be kept as they are")
+	}
+}
+
+func TestRedactBodyEnvironmentVariables(t *testing.T) {
+	body, _ := json.Marshal(VaporEnvironmentVariables{
+		Variables: map[string]string{"APP_KEY": "base64:secret-key", "STRIPE_SECRET": "sk_live_123"},
+	})
+
+	redacted := redactBody(body)
+
+	for _, sensitive := range []string{"base64:secret-key", "sk_live_123"} {
+		if strings.Contains(redacted, sensitive) {
+			t.Errorf("expected %q to be redacted from %s", sensitive, redacted)
+		}
+	}
+
+	// Names are kept to tell which variables were sent
+	if redacted != `{"variables":{"APP_KEY":"`+redactedValue+`","STRIPE_SECRET":"`+redactedValue+`"}}` {
+		t.Errorf("expected only values to be redacted, got %s", redacted)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer secret-token")
+	headers.Set("Accept", "application/json")
+
+	redacted := redactHeaders(headers)
+
+	if redacted["Authorization"] != redactedValue {
+		t.Errorf("expected Authorization header to be redacted, got %q", redacted["Authorization"])
+	}
+
+	if redacted["Accept"] != "application/json" {
+		t.Errorf("expected Accept header to be kept, got %q", redacted["Accept"])
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	err := client.RemoveZone(context.Background(), 1)
+
+	if !isNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if isNotFound(errors.New("404")) {
+		t.Error("expected plain errors not to be reported as not found")
+	}
+}
+
+func TestIsUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message":"Unauthenticated."}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	_, err := client.GetAccount(context.Background())
+
+	if !isUnauthorized(err) {
+		t.Errorf("expected an unauthorized error, got %v", err)
+	}
+
+	if isUnauthorized(errors.New("401")) {
+		t.Error("expected plain errors not to be reported as unauthorized")
+	}
+}
+
+func TestGetProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/providers/5" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":5,"name":"production","type":"aws"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	provider, err := client.GetProvider(context.Background(), 5)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if provider.Id != 5 || provider.Name != "production" {
+		t.Errorf("unexpected provider: %+v", provider)
+	}
+
+	_, err = client.GetProvider(context.Background(), 6)
+
+	if !isNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestGetTeam(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/teams/3" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":3,"name":"Acme","owner":{"id":1,"name":"Owner"}}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	team, err := client.GetTeam(context.Background(), 3)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if team.Id != 3 || team.Name != "Acme" || team.Owner.Id != 1 {
+		t.Errorf("unexpected team: %+v", team)
+	}
+
+	_, err = client.GetTeam(context.Background(), 4)
+
+	if !isNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestUpdateTeamPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/teams/3" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		// Sentry settings are always sent, empty ones remove them
+		if string(body) != `{"name":"Renamed","sentry_organization_name":"","sentry_organization_region":""}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":3,"name":"Renamed"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	team, err := client.UpdateTeam(context.Background(), 3, Team{Name: "Renamed"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if team.Id != 3 || team.Name != "Renamed" {
+		t.Errorf("unexpected team: %+v", team)
+	}
+}
+
+func TestUpdateTeamSentryPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		if string(body) != `{"name":"Acme","sentry_organization_name":"acme","sentry_organization_region":"de"}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":3,"name":"Acme","sentry_organization_name":"acme","sentry_organization_region":"de"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	team, err := client.UpdateTeam(context.Background(), 3, Team{
+		Name:                     "Acme",
+		SentryOrganisationName:   "acme",
+		SentryOrganisationRegion: "de",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if team.SentryOrganisationName != "acme" || team.SentryOrganisationRegion != "de" {
+		t.Errorf("unexpected team: %+v", team)
+	}
+}
+
+func TestUpdateProviderPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/providers/5" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		if string(body) != `{"name":"staging","role_sync":false}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":5,"name":"staging"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	provider, err := client.UpdateProvider(context.Background(), 5, VaporProvider{Name: "staging"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if provider.Name != "staging" {
+		t.Errorf("unexpected provider: %+v", provider)
+	}
+}
+
+func TestUpdateProviderLimitsPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		// Zero is a valid limit and must be sent
+		if string(body) != `{"name":"staging","role_sync":true,"network_limit":10,"concurrency":100,"unreserved_concurrency":0}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":5,"name":"staging","concurrency":100,"unreserved_concurrency":0}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+	concurrency, unreservedConcurrency := 100, 0
+
+	provider, err := client.UpdateProvider(context.Background(), 5, VaporProvider{
+		Name:                  "staging",
+		RoleSync:              true,
+		NetworkLimit:          10,
+		Concurrency:           &concurrency,
+		UnreservedConcurrency: &unreservedConcurrency,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if provider.UnreservedConcurrency == nil || *provider.UnreservedConcurrency != 0 {
+		t.Errorf("unexpected provider: %+v", provider)
+	}
+}
+
+func TestUpdateTeamMemberPayload(t *testing.T) {
+	var payloads []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/teams/3/members" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		payloads = append(payloads, string(body))
+
+		_, _ = w.Write([]byte(`{"id":9,"email":"member@example.com"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	if _, err := client.UpdateTeamMember(context.Background(), 3, "member@example.com", []string{"view-projects", "deploy-projects"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := client.UpdateTeamMember(context.Background(), 3, "member@example.com", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		`{"email":"member@example.com","permissions":["view-projects","deploy-projects"]}`,
+		`{"email":"member@example.com","permissions":[]}`,
+	}
+
+	for i := range expected {
+		if i >= len(payloads) || payloads[i] != expected[i] {
+			t.Errorf("expected payload %s, got %v", expected[i], payloads)
+		}
+	}
+}
+
+func TestCreateProviderDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/teams/3/providers" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		if string(body) != `{"type":"aws","name":"production","meta":{"key":"AKIA","secret":"shh"}}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":5,"uuid":"abc","role_arn":"arn:aws:iam::1:role/vapor"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	provider, err := client.CreateProvider(context.Background(), 3, VaporProvider{Type: "aws", Name: "production"}, "AKIA", "shh")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if provider.Id != 5 || provider.Uuid != "abc" || provider.RoleArn != "arn:aws:iam::1:role/vapor" {
+		t.Errorf("unexpected provider: %+v", provider)
+	}
+}
+
+func TestUpdateEnvironmentVariablesPayload(t *testing.T) {
+	var payloads []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/environments/4/variables" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		payloads = append(payloads, string(body))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	if err := client.UpdateEnvironmentVariables(context.Background(), 4, map[string]string{"APP_ENV": "staging", "APP_DEBUG": "false"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := client.UpdateEnvironmentVariables(context.Background(), 4, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		`{"variables":{"APP_DEBUG":"false","APP_ENV":"staging"}}`,
+		`{"variables":{}}`,
+	}
+
+	for i := range expected {
+		if i >= len(payloads) || payloads[i] != expected[i] {
+			t.Errorf("expected payload %s, got %v", expected[i], payloads)
+		}
+	}
+}
+
+func TestWaitForStatus(t *testing.T) {
+	statuses := []string{"creating", "creating", "available"}
+	calls := 0
+
+	status, err := waitForStatus(context.Background(), time.Millisecond, []string{"creating"}, func() (string, error) {
+		status := statuses[calls]
+		calls++
+
+		return status, nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if status != "available" || calls != 3 {
+		t.Errorf("expected to stop on available after 3 calls, got %q after %d", status, calls)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = waitForStatus(ctx, time.Millisecond, []string{"creating"}, func() (string, error) {
+		return "creating", nil
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline error, got %v", err)
+	}
+}
+
+func TestGetEnvironmentMetricsEncodesPeriod(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/environments/4/metrics" || r.URL.RawQuery != "period=1+day%261" {
+			t.Errorf("unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+
+		_, _ = w.Write([]byte(`{"invocations":120,"errors":3,"average_duration":45.5}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	metrics, err := client.GetEnvironmentMetrics(context.Background(), 4, "1 day&1")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if metrics.Invocations != 120 || metrics.Errors != 3 || metrics.AverageDuration != 45.5 {
+		t.Errorf("unexpected metrics: %+v", metrics)
+	}
+}
+
+func TestGetZoneDecodesNameservers(t *testing.T) {
+	tests := map[string]struct {
+		body     string
+		expected []string
+	}{
+		"list":    {body: `{"id": 1, "nameservers": ["ns-1.awsdns.com", "ns-2.awsdns.net"]}`, expected: []string{"ns-1.awsdns.com", "ns-2.awsdns.net"}},
+		"keyed":   {body: `{"id": 1, "nameservers": {"1": "ns-2.awsdns.net", "0": "ns-1.awsdns.com"}}`, expected: []string{"ns-1.awsdns.com", "ns-2.awsdns.net"}},
+		"null":    {body: `{"id": 1, "nameservers": null}`, expected: nil},
+		"missing": {body: `{"id": 1}`, expected: nil},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := VaporClient{apiHost: server.URL}
+
+			zone, err := client.GetZone(context.Background(), 1)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.Equal(zone.Nameservers, test.expected) {
+				t.Errorf("expected nameservers %v, got %v", test.expected, zone.Nameservers)
+			}
+		})
+	}
+}
+
+func TestCreateZoneRecordsReturnsCreatedRecordsOnFailure(t *testing.T) {
+	var mutex sync.Mutex
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		requests++
+		mutex.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+
+		if strings.Contains(string(body), `"name":"broken"`) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"message": "The given data was invalid."}`))
+			return
+		}
+
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	records := []VaporZoneRecord{
+		{Type: "A", Name: "@", Value: "127.0.0.1"},
+		{Type: "A", Name: "broken", Value: "127.0.0.2"},
+		{Type: "CNAME", Name: "www", Value: "example.com"},
+		{Type: "TXT", Name: "@", Value: "v=spf1 ~all"},
+		{Type: "TXT", Name: "_dmarc", Value: "v=DMARC1; p=none"},
+	}
+
+	created, err := client.CreateZoneRecords(context.Background(), 1, records)
+
+	if err == nil {
+		t.Fatal("expected an error for the broken record")
+	}
+
+	if requests != len(records) {
+		t.Errorf("expected %d requests, got %d", len(records), requests)
+	}
+
+	if len(created) != len(records) {
+		t.Fatalf("expected a result for each of the %d records, got %d", len(records), len(created))
+	}
+
+	for i, record := range created {
+		if records[i].Name == "broken" {
+			if record != nil {
+				t.Errorf("expected no result for the broken record, got %+v", record)
+			}
+
+			continue
+		}
+
+		if record == nil || record.ZoneId != 1 || record.Name != records[i].Name {
+			t.Errorf("unexpected created record %d: %+v", i, record)
+		}
+	}
+}
+
+func TestUpdateZoneRecordPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/zones/1/records/7" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		if string(body) != `{"value":"127.0.0.2","ttl":60}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":7,"zone_id":1,"type":"A","name":"@","value":"127.0.0.2","ttl":60}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	record, err := client.UpdateZoneRecord(context.Background(), VaporZoneRecord{Id: 7, ZoneId: 1, Type: "A", Name: "@", Value: "127.0.0.2", Ttl: 60})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if record.Value != "127.0.0.2" || record.Ttl != 60 {
+		t.Errorf("unexpected record: %+v", record)
+	}
+}
+
+func TestScaleDatabasePayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/databases/1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		if string(body) != `{"instance_class":"db.t3.small"}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"instance_class":"db.t3.small","status":"scaling"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	database, err := client.ScaleDatabase(context.Background(), 1, "db.t3.small")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if database.Status != "scaling" {
+		t.Errorf("unexpected database: %+v", database)
+	}
+}
+
+func TestCreateDatabaseUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/databases/1/users" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		if string(body) != `{"username":"billing"}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":7,"database_id":1,"username":"billing","password":"secret"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	user, err := client.CreateDatabaseUser(context.Background(), 1, "billing")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if user.Id != 7 || user.Password != "secret" {
+		t.Errorf("unexpected database user: %+v", user)
+	}
+}
+
+func TestCreateAlarmPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/teams/1/alarms" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		// A zero threshold is a valid one and must be sent
+		if string(body) != `{"metric":"errors","operator":"GreaterThanThreshold","threshold":0,"period":300,"notification_target":"ops@example.com"}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":3,"team_id":1,"metric":"errors","operator":"GreaterThanThreshold","threshold":0,"period":300,"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	alarm, err := client.CreateAlarm(context.Background(), 1, VaporAlarm{
+		Metric:             "errors",
+		Operator:           "GreaterThanThreshold",
+		Period:             300,
+		NotificationTarget: "ops@example.com",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if alarm.Id != 3 || alarm.Status != "ok" {
+		t.Errorf("unexpected alarm: %+v", alarm)
+	}
+}
+
+func TestUpdateNotificationPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/notifications/5" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		// The type cannot be changed, it is never sent on updates
+		if string(body) != `{"destination":"ops@example.com","events":["deployment.failed"]}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":5,"type":"email","destination":"ops@example.com","events":["deployment.failed"]}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	_, err := client.UpdateNotification(context.Background(), 5, VaporNotification{
+		Type:        "email",
+		Destination: "ops@example.com",
+		Events:      []string{"deployment.failed"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestAttachEnvironmentDatabasePayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/environments/1/databases" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		if string(body) != `{"database_id":2}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":2}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	if err := client.AttachEnvironmentDatabase(context.Background(), 1, 2); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestGetDeploymentLogKeepsTheEndOfLongLogs(t *testing.T) {
+	line := strings.Repeat("x", 1023) + "\n"
+	lines := maxDeploymentLogSize/len(line) + 10
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/deployments/1/log" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		for i := 0; i < lines; i++ {
+			_, _ = w.Write([]byte(line))
+		}
+
+		_, _ = w.Write([]byte("Deployment failed"))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	log, truncated, err := client.GetDeploymentLog(context.Background(), 1)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !truncated {
+		t.Error("expected the log to be truncated")
+	}
+
+	if len(log) != maxDeploymentLogSize {
+		t.Errorf("expected %d bytes to be kept, got %d", maxDeploymentLogSize, len(log))
+	}
+
+	if !strings.HasSuffix(log, "Deployment failed") {
+		t.Error("expected the end of the log to be kept")
+	}
+}
+
+func TestTailBufferDropsOnlyTheCutCharacter(t *testing.T) {
+	log := &tailBuffer{size: 4}
+
+	// "é" is cut in half, the invalid byte further in the log is kept as it was sent
+	_, _ = log.Write([]byte("caf\xc3\xa9\xffok"))
+
+	if got := log.String(); got != "\xffok" {
+		t.Errorf("expected only the cut character to be dropped, got %q", got)
+	}
+
+	log = &tailBuffer{size: 6}
+
+	_, _ = log.Write([]byte("ok\xff"))
+
+	if got := log.String(); got != "ok\xff" {
+		t.Errorf("expected untruncated logs to be kept as they are, got %q", got)
+	}
+}
+
+func TestGetDeploymentLogError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not Found."}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	log, truncated, err := client.GetDeploymentLog(context.Background(), 1)
+
+	if !isNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if log != "" || truncated {
+		t.Errorf("expected no log, got %q", log)
+	}
+}
+
+func TestRollbackEnvironmentPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/environments/1/rollback" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		if string(body) != `{"deployment_id":4}` {
+			t.Errorf("unexpected payload %s", body)
+		}
+
+		_, _ = w.Write([]byte(`{"id":9,"environment_id":1,"status":"pending"}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	deployment, err := client.RollbackEnvironment(context.Background(), 1, 4)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if deployment.Id != 9 {
+		t.Errorf("unexpected deployment: %+v", deployment)
+	}
+}
+
+func TestGetZoneSesRecords(t *testing.T) {
+	tests := map[string]struct {
+		body     string
+		expected []VaporZoneSesRecord
+	}{
+		"pending": {
+			body:     `{"id": 1, "ses_verified": false, "ses_dns_records": [{"type": "TXT", "name": "_amazonses.example.com", "value": "token"}]}`,
+			expected: []VaporZoneSesRecord{{Type: "TXT", Name: "_amazonses.example.com", Value: "token"}},
+		},
+		"verified": {
+			body:     `{"id": 1, "ses_verified": true, "ses_dns_records": [{"type": "TXT", "name": "_amazonses.example.com", "value": "token"}]}`,
+			expected: []VaporZoneSesRecord{},
+		},
+		"missing": {
+			body:     `{"id": 1, "ses_verified": false}`,
+			expected: []VaporZoneSesRecord{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := newTestServer(t, testRoutes{
+				"GET /api/zones/1": test.body,
+			})
+
+			client := VaporClient{apiHost: server.URL}
+
+			records, err := client.GetZoneSesRecords(context.Background(), 1)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if records == nil || !slices.Equal(records, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, records)
+			}
+		})
+	}
+}
+
+func TestFlexIntUnmarshal(t *testing.T) {
+	tests := map[string]struct {
+		body      string
+		expected  FlexInt
+		wantError bool
+	}{
+		"number":       {body: `123`, expected: 123},
+		"string":       {body: `"123"`, expected: 123},
+		"null":         {body: `null`, expected: 0},
+		"empty string": {body: `""`, expected: 0},
+		"not a number": {body: `"abc"`, wantError: true},
+		"decimal":      {body: `1.5`, wantError: true},
+		"unexpected":   {body: `{}`, wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var value FlexInt
+
+			err := json.Unmarshal([]byte(test.body), &value)
+
+			if (err != nil) != test.wantError {
+				t.Fatalf("expected error to be %t, got: %v", test.wantError, err)
+			}
+
+			if value != test.expected {
+				t.Errorf("expected %d, got %d", test.expected, value)
+			}
+		})
+	}
+}
+
+func TestFlexIntZoneRecord(t *testing.T) {
+	for _, body := range []string{
+		`{"id": 7, "zone_id": 3, "type": "A"}`,
+		`{"id": "7", "zone_id": "3", "type": "A"}`,
+	} {
+		record := VaporZoneRecord{}
+
+		if err := json.Unmarshal([]byte(body), &record); err != nil {
+			t.Fatalf("unexpected error decoding %s: %s", body, err)
+		}
+
+		if record.Id != 7 || record.ZoneId != 3 {
+			t.Errorf("expected ids to be decoded from %s, got %+v", body, record)
+		}
+	}
+
+	// IDs are always sent back as numbers
+	payload, _ := json.Marshal(VaporZoneRecord{Id: 7, ZoneId: 3})
+
+	if !strings.Contains(string(payload), `"zone_id":3`) {
+		t.Errorf("expected a numeric zone_id, got %s", payload)
+	}
+}
+
+func TestRemoveEnvironmentEscapesName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.RequestURI != "/api/projects/1/environments/feature%2Fbilling%3Fv2" {
+			t.Errorf("unexpected request %s %s", r.Method, r.RequestURI)
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := VaporClient{apiHost: server.URL}
+
+	if err := client.RemoveEnvironment(context.Background(), 1, "feature/billing?v2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
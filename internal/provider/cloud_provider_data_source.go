@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudProviderDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &CloudProviderDataSource{}
+
+func NewCloudProviderDataSource() datasource.DataSource {
+	return &CloudProviderDataSource{}
+}
+
+// CloudProviderDataSource defines the data source implementation.
+type CloudProviderDataSource struct {
+	client VaporClient
+}
+
+// CloudProviderDataSourceModel describes the data source data model.
+type CloudProviderDataSourceModel struct {
+	Id                    types.Int32  `tfsdk:"id"`
+	TeamId                types.Int32  `tfsdk:"team_id"`
+	Name                  types.String `tfsdk:"name"`
+	Type                  types.String `tfsdk:"type"`
+	Uuid                  types.String `tfsdk:"uuid"`
+	RoleArn               types.String `tfsdk:"role_arn"`
+	RoleSync              types.Bool   `tfsdk:"role_sync"`
+	SnsTopicArn           types.String `tfsdk:"sns_topic_arn"`
+	Concurrency           types.Int32  `tfsdk:"concurrency"`
+	UnreservedConcurrency types.Int32  `tfsdk:"unreserved_concurrency"`
+}
+
+func (d *CloudProviderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_provider"
+}
+
+func (d *CloudProviderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Get a team cloud provider by its ID or name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Cloud provider ID, conflicts with `name`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID the cloud provider belongs to",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider name, conflicts with `id`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider type",
+				Computed:            true,
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider UUID",
+				Computed:            true,
+			},
+			"role_arn": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider IAM role ARN",
+				Computed:            true,
+			},
+			"role_sync": schema.BoolAttribute{
+				MarkdownDescription: "Is the cloud provider IAM role synced",
+				Computed:            true,
+			},
+			"sns_topic_arn": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider SNS topic ARN",
+				Computed:            true,
+			},
+			"concurrency": schema.Int32Attribute{
+				MarkdownDescription: "Cloud provider Lambda concurrency limit",
+				Computed:            true,
+			},
+			"unreserved_concurrency": schema.Int32Attribute{
+				MarkdownDescription: "Cloud provider Lambda unreserved concurrency",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CloudProviderDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data CloudProviderDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values might be known only after apply
+	if data.Id.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.Id.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Attribute Combination",
+			"Exactly one of `id` or `name` must be configured.",
+		)
+	}
+}
+
+func (d *CloudProviderDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CloudProviderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudProviderDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var provider *VaporProvider
+
+	if !data.Id.IsNull() {
+		var err error
+
+		provider, err = d.client.GetProvider(ctx, int(data.Id.ValueInt32()))
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud provider, got error: %s", err))
+			return
+		}
+	} else {
+		providers, err := d.client.GetProviders(ctx, int(data.TeamId.ValueInt32()))
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cloud providers, got error: %s", err))
+			return
+		}
+
+		matches := []VaporProvider{}
+
+		for _, p := range providers {
+			if p.Name == data.Name.ValueString() {
+				matches = append(matches, p)
+			}
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError("Cloud Provider Not Found", fmt.Sprintf("No cloud provider matches the name %q.", data.Name.ValueString()))
+			return
+		}
+
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError("Multiple Cloud Providers Found", fmt.Sprintf("%d cloud providers match the name %q, use `id` instead.", len(matches), data.Name.ValueString()))
+			return
+		}
+
+		provider = &matches[0]
+	}
+
+	data.Id = types.Int32Value(int32(provider.Id))
+	data.Name = types.StringValue(provider.Name)
+	data.Type = types.StringValue(provider.Type)
+	data.Uuid = types.StringValue(provider.Uuid)
+	data.RoleArn = types.StringValue(provider.RoleArn)
+	data.RoleSync = types.BoolValue(provider.RoleSync)
+	data.SnsTopicArn = types.StringValue(provider.SnsTopicArn)
+	data.Concurrency = intPointerValue(provider.Concurrency)
+	data.UnreservedConcurrency = intPointerValue(provider.UnreservedConcurrency)
+
+	tflog.Trace(ctx, "read cloud provider data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
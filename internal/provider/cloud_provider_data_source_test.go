@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudProviderDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/teams/79169/providers": `[{"id": 6, "team_id": 79169, "type": "aws", "name": "production"}, {"id": 7, "team_id": 79169, "type": "aws", "name": "terraform", "uuid": "5d1f0c3e-2b4a-4c8e-9f6d-0a1b2c3d4e5f", "role_arn": "arn:aws:iam::123456789012:role/laravel-vapor-role", "role_sync": true, "concurrency": 100}]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCloudProviderDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_cloud_provider.test", "name", "terraform"),
+					resource.TestCheckResourceAttr("data.laravelvapor_cloud_provider.test", "id", "7"),
+					resource.TestCheckResourceAttr("data.laravelvapor_cloud_provider.test", "type", "aws"),
+					resource.TestCheckResourceAttr("data.laravelvapor_cloud_provider.test", "uuid", "5d1f0c3e-2b4a-4c8e-9f6d-0a1b2c3d4e5f"),
+					resource.TestCheckResourceAttr("data.laravelvapor_cloud_provider.test", "role_arn", "arn:aws:iam::123456789012:role/laravel-vapor-role"),
+					resource.TestCheckResourceAttr("data.laravelvapor_cloud_provider.test", "role_sync", "true"),
+					resource.TestCheckResourceAttr("data.laravelvapor_cloud_provider.test", "concurrency", "100"),
+					resource.TestCheckNoResourceAttr("data.laravelvapor_cloud_provider.test", "unreserved_concurrency"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudProviderDataSourceConfig = `
+data "laravelvapor_cloud_provider" "test" {
+  team_id = 79169
+  name    = "terraform"
+}
+`
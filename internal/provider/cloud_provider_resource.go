@@ -0,0 +1,614 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CloudProviderResource{}
+var _ resource.ResourceWithImportState = &CloudProviderResource{}
+var _ resource.ResourceWithValidateConfig = &CloudProviderResource{}
+
+func NewCloudProviderResource() resource.Resource {
+	return &CloudProviderResource{}
+}
+
+// CloudProviderResource defines the resource implementation.
+type CloudProviderResource struct {
+	client VaporClient
+}
+
+// CloudProviderResourceModel describes the resource data model.
+type CloudProviderResourceModel struct {
+	Id                    types.Int32    `tfsdk:"id"`
+	TeamId                types.Int32    `tfsdk:"team_id"`
+	Type                  types.String   `tfsdk:"type"`
+	Name                  types.String   `tfsdk:"name"`
+	Key                   types.String   `tfsdk:"key"`
+	Secret                types.String   `tfsdk:"secret"`
+	RoleSync              types.Bool     `tfsdk:"role_sync"`
+	NetworkLimit          types.Int32    `tfsdk:"network_limit"`
+	Concurrency           types.Int32    `tfsdk:"concurrency"`
+	UnreservedConcurrency types.Int32    `tfsdk:"unreserved_concurrency"`
+	Uuid                  types.String   `tfsdk:"uuid"`
+	RoleArn               types.String   `tfsdk:"role_arn"`
+	SnsTopicArn           types.String   `tfsdk:"sns_topic_arn"`
+	WaitForRoleSync       types.Bool     `tfsdk:"wait_for_role_sync"`
+	WaitForDeletion       types.Bool     `tfsdk:"wait_for_deletion"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CloudProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_provider"
+}
+
+func (r *CloudProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a cloud provider (AWS account) linked to a team. " +
+			"Credentials cannot be read back from the API, imported cloud providers need `key` and `secret` set in the configuration, " +
+			"they are sent on the next apply without replacing the cloud provider.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Cloud provider ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID the cloud provider belongs to, defaults to the provider `team_id`. Changing it recreates the cloud provider",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider type (only `aws` is supported), changing it recreates the cloud provider",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(cloudProviderTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider name",
+				Required:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider access key ID",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider secret access key",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"role_sync": schema.BoolAttribute{
+				MarkdownDescription: "Keep the cloud provider IAM role permissions synced by Laravel Vapor",
+				Optional:            true,
+				Computed:            true,
+			},
+			"network_limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of networks allowed on the cloud provider",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"concurrency": schema.Int32Attribute{
+				MarkdownDescription: "Lambda concurrency limit of the AWS account",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"unreserved_concurrency": schema.Int32Attribute{
+				MarkdownDescription: "Lambda concurrency left unreserved, cannot exceed `concurrency`",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider IAM role ARN",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sns_topic_arn": schema.StringAttribute{
+				MarkdownDescription: "Cloud provider SNS topic ARN",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_role_sync": schema.BoolAttribute{
+				MarkdownDescription: "Wait on creation until the cloud provider IAM role is synced, so resources depending on `role_arn` can be created in the same apply",
+				Optional:            true,
+			},
+			"wait_for_deletion": schema.BoolAttribute{
+				MarkdownDescription: "Wait on destroy until the cloud provider queued for deletion is removed, so its AWS account can be linked again right away",
+				Optional:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *CloudProviderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CloudProviderResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values might be known only after apply
+	if data.Concurrency.IsUnknown() || data.Concurrency.IsNull() || data.UnreservedConcurrency.IsUnknown() || data.UnreservedConcurrency.IsNull() {
+		return
+	}
+
+	if data.UnreservedConcurrency.ValueInt32() > data.Concurrency.ValueInt32() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("unreserved_concurrency"),
+			"Invalid Attribute Configuration",
+			"`unreserved_concurrency` cannot exceed `concurrency`.",
+		)
+	}
+}
+
+func (r *CloudProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CloudProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CloudProviderResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId, diags := teamIdOrDefault(data.TeamId, r.client)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamId = teamId
+
+	provider, err := r.client.CreateProvider(
+		ctx,
+		int(data.TeamId.ValueInt32()),
+		VaporProvider{
+			Type:         data.Type.ValueString(),
+			Name:         data.Name.ValueString(),
+			NetworkLimit: int(data.NetworkLimit.ValueInt32()),
+		},
+		data.Key.ValueString(),
+		data.Secret.ValueString(),
+	)
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create cloud provider", err, data.identity()))
+		return
+	}
+
+	// Nothing was sent in dry run mode, there is nothing to look up or wait for
+	if r.client.dryRun {
+		if data.RoleSync.IsUnknown() {
+			data.RoleSync = types.BoolValue(provider.RoleSync)
+		}
+
+		data.setComputed(provider)
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	// Some responses do not include the created provider, look it up by name instead
+	if provider.Id == 0 {
+		providers, err := r.client.GetProviders(ctx, int(data.TeamId.ValueInt32()))
+
+		if err != nil {
+			resp.Diagnostics.Append(newClientError("read cloud providers", err, data.identity()))
+			return
+		}
+
+		provider = findProvider(providers, 0, data.Name.ValueString())
+
+		if provider == nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find created cloud provider %q", data.Name.ValueString()))
+			return
+		}
+	}
+
+	// Role sync and concurrency cannot be sent on creation, apply them afterwards when configured
+	roleSyncChanged := !data.RoleSync.IsUnknown() && !data.RoleSync.IsNull() && data.RoleSync.ValueBool() != provider.RoleSync
+
+	if roleSyncChanged || !data.Concurrency.IsUnknown() || !data.UnreservedConcurrency.IsUnknown() {
+		roleSync := provider.RoleSync
+
+		if roleSyncChanged {
+			roleSync = data.RoleSync.ValueBool()
+		}
+
+		_, err = r.client.UpdateProvider(ctx, int(provider.Id), data.toUpdates(roleSync))
+
+		if err != nil {
+			resp.Diagnostics.Append(newClientError("update cloud provider", err, data.identity()))
+			return
+		}
+
+		provider.RoleSync = roleSync
+	}
+
+	if data.WaitForRoleSync.ValueBool() {
+		createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+
+		providerId := int(provider.Id)
+
+		_, err = waitForStatus(waitCtx, defaultPollInterval, []string{"syncing"}, func() (string, error) {
+			synced, err := r.client.GetProvider(waitCtx, providerId)
+
+			if err != nil {
+				return "syncing", err
+			}
+
+			provider = synced
+
+			if !provider.RoleSync && provider.RoleArn == "" {
+				return "syncing", nil
+			}
+
+			return "synced", nil
+		})
+
+		// Provider is kept in state even when the role sync does not complete, so it is tainted instead of leaked
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Role Sync Not Completed",
+				fmt.Sprintf("Cloud provider %s IAM role was not synced, check its permissions on the AWS account: %s", data.Name.ValueString(), err),
+			)
+		}
+	}
+
+	data.RoleSync = types.BoolValue(provider.RoleSync)
+	data.setComputed(provider)
+
+	tflog.Trace(ctx, "created a cloud provider resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CloudProviderResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider, err := r.client.GetProvider(ctx, int(data.Id.ValueInt32()))
+
+	// Provider was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read cloud provider", err, data.identity()))
+		return
+	}
+
+	// Provider is being deleted asynchronously, it must be created again instead of being reported as healthy
+	if provider.QueuedForDeletion {
+		tflog.Warn(ctx, "Cloud provider is queued for deletion, removing it from state", map[string]interface{}{"id": data.Id.ValueInt32()})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Imported providers only have their ID in state
+	if provider.TeamId != 0 {
+		data.TeamId = types.Int32Value(int32(provider.TeamId))
+	}
+
+	data.Name = types.StringValue(provider.Name)
+	data.Type = types.StringValue(provider.Type)
+	data.RoleSync = types.BoolValue(provider.RoleSync)
+	data.NetworkLimit = types.Int32Value(int32(provider.NetworkLimit))
+	data.Concurrency = intPointerValue(provider.Concurrency)
+	data.UnreservedConcurrency = intPointerValue(provider.UnreservedConcurrency)
+	data.setComputed(provider)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CloudProviderResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerId := int(state.Id.ValueInt32())
+
+	if !data.Name.Equal(state.Name) || (!data.RoleSync.IsUnknown() && !data.RoleSync.Equal(state.RoleSync)) ||
+		!data.NetworkLimit.Equal(state.NetworkLimit) || !data.Concurrency.Equal(state.Concurrency) ||
+		!data.UnreservedConcurrency.Equal(state.UnreservedConcurrency) {
+		roleSync := state.RoleSync.ValueBool()
+
+		if !data.RoleSync.IsUnknown() {
+			roleSync = data.RoleSync.ValueBool()
+		}
+
+		_, err := r.client.UpdateProvider(ctx, providerId, data.toUpdates(roleSync))
+
+		if err != nil {
+			resp.Diagnostics.Append(newClientError("update cloud provider", err, data.identity()))
+			return
+		}
+	}
+
+	if !data.Key.Equal(state.Key) || !data.Secret.Equal(state.Secret) {
+		err := r.client.UpdateProviderCredentials(ctx, providerId, data.Key.ValueString(), data.Secret.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.Append(newClientError("update cloud provider credentials", err, data.identity()))
+			return
+		}
+	}
+
+	provider, err := r.client.GetProvider(ctx, providerId)
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read cloud provider", err, data.identity()))
+		return
+	}
+
+	if data.RoleSync.IsUnknown() {
+		data.RoleSync = types.BoolValue(provider.RoleSync)
+	}
+
+	data.setComputed(provider)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CloudProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CloudProviderResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveProvider(ctx, int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete cloud provider", err, data.identity()))
+		return
+	}
+
+	// Nothing was sent in dry run mode, the provider is never deleted
+	if !data.WaitForDeletion.ValueBool() || r.client.dryRun {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Providers are queued for deletion, they are still returned until the deletion is done
+	_, err = waitForStatus(waitCtx, defaultPollInterval, []string{"deleting"}, func() (string, error) {
+		_, err := r.client.GetProvider(waitCtx, int(data.Id.ValueInt32()))
+
+		if isNotFound(err) {
+			return "deleted", nil
+		}
+
+		return "deleting", err
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("wait for cloud provider deletion", err, data.identity()))
+		return
+	}
+}
+
+func (r *CloudProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 32)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a numeric cloud provider ID, got: %q", req.ID),
+		)
+
+		return
+	}
+
+	// Key and secret are left null, they are never returned by the API
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int32(id))...)
+}
+
+// toUpdates returns the updatable cloud provider attributes, limits are left out until known.
+func (data *CloudProviderResourceModel) toUpdates(roleSync bool) VaporProvider {
+	return VaporProvider{
+		Name:                  data.Name.ValueString(),
+		RoleSync:              roleSync,
+		NetworkLimit:          int(data.NetworkLimit.ValueInt32()),
+		Concurrency:           intPointer(data.Concurrency),
+		UnreservedConcurrency: intPointer(data.UnreservedConcurrency),
+	}
+}
+
+func (data *CloudProviderResourceModel) setComputed(provider *VaporProvider) {
+	// Limits not configured are taken from the API
+	if data.NetworkLimit.IsUnknown() {
+		data.NetworkLimit = types.Int32Value(int32(provider.NetworkLimit))
+	}
+
+	if data.Concurrency.IsUnknown() {
+		data.Concurrency = intPointerValue(provider.Concurrency)
+	}
+
+	if data.UnreservedConcurrency.IsUnknown() {
+		data.UnreservedConcurrency = intPointerValue(provider.UnreservedConcurrency)
+	}
+
+	data.Id = types.Int32Value(int32(provider.Id))
+	data.Uuid = types.StringValue(provider.Uuid)
+	data.RoleArn = types.StringValue(provider.RoleArn)
+	data.SnsTopicArn = types.StringValue(provider.SnsTopicArn)
+}
+
+// findProvider returns the provider matching the given id, or name when id is zero.
+func findProvider(providers []VaporProvider, id int, name string) *VaporProvider {
+	for i := range providers {
+		if id != 0 && int(providers[i].Id) == id {
+			return &providers[i]
+		}
+
+		if id == 0 && providers[i].Name == name {
+			return &providers[i]
+		}
+	}
+
+	return nil
+}
+
+// intPointer converts an optional number to the API representation, nil when null or unknown.
+func intPointer(value types.Int32) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	number := int(value.ValueInt32())
+
+	return &number
+}
+
+// intPointerValue converts an optional API number, null when it was not returned.
+func intPointerValue(number *int) types.Int32 {
+	if number == nil {
+		return types.Int32Null()
+	}
+
+	return types.Int32Value(int32(*number))
+}
+
+// identity returns the attributes identifying the cloud provider in diagnostics.
+func (data *CloudProviderResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":      data.Id,
+		"team_id": data.TeamId,
+		"name":    data.Name,
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccCloudProviderResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/teams/79169/providers": `{"id": 7, "team_id": 79169, "type": "aws", "name": "terraform", "uuid": "5d1f0c3e-2b4a-4c8e-9f6d-0a1b2c3d4e5f", "network_limit": 10}`,
+		"GET /api/providers/7":            `{"id": 7, "team_id": 79169, "type": "aws", "name": "terraform", "uuid": "5d1f0c3e-2b4a-4c8e-9f6d-0a1b2c3d4e5f", "role_arn": "arn:aws:iam::123456789012:role/laravel-vapor-role", "role_sync": true, "sns_topic_arn": "arn:aws:sns:us-east-1:123456789012:vapor", "network_limit": 10}`,
+		"PUT /api/providers/7":            `{}`,
+		"DELETE /api/providers/7":         ``,
+		"POST /api/teams/79170/providers": `{"id": 8, "team_id": 79170, "type": "aws", "name": "terraform-renamed", "uuid": "6e2a1d4f-3c5b-4d9f-8a7e-1b2c3d4e5f6a", "network_limit": 10}`,
+		"GET /api/providers/8":            `{"id": 8, "team_id": 79170, "type": "aws", "name": "terraform-renamed", "uuid": "6e2a1d4f-3c5b-4d9f-8a7e-1b2c3d4e5f6a", "role_arn": "arn:aws:iam::123456789012:role/laravel-vapor-role", "role_sync": true, "sns_topic_arn": "arn:aws:sns:us-east-1:123456789012:vapor", "network_limit": 10}`,
+		"DELETE /api/providers/8":         ``,
+	})
+
+	server.setRouteAfter("PUT /api/providers/7", "GET /api/providers/7", `{"id": 7, "team_id": 79169, "type": "aws", "name": "terraform-renamed", "uuid": "5d1f0c3e-2b4a-4c8e-9f6d-0a1b2c3d4e5f", "role_arn": "arn:aws:iam::123456789012:role/laravel-vapor-role", "role_sync": true, "sns_topic_arn": "arn:aws:sns:us-east-1:123456789012:vapor", "network_limit": 10}`)
+	server.setRouteAfter("PUT /api/providers/7", "GET /api/providers/7", `{"id": 7, "team_id": 79169, "type": "aws", "name": "terraform-renamed", "uuid": "5d1f0c3e-2b4a-4c8e-9f6d-0a1b2c3d4e5f", "role_arn": "arn:aws:iam::123456789012:role/laravel-vapor-role", "role_sync": true, "sns_topic_arn": "arn:aws:sns:us-east-1:123456789012:vapor", "network_limit": 10, "concurrency": 100, "unreserved_concurrency": 50}`)
+	server.removeRouteAfter("DELETE /api/providers/7", "GET /api/providers/7")
+	server.removeRouteAfter("DELETE /api/providers/8", "GET /api/providers/8")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Invalid type testing
+			{
+				Config:      testAccCloudProviderResourceInvalidTypeConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Invalid concurrency testing
+			{
+				Config:      testAccCloudProviderResourceConcurrencyConfig(100, 200),
+				ExpectError: regexp.MustCompile("cannot exceed"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCloudProviderResourceConfig(79169, "terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/teams/79169/providers", `{"type": "aws", "name": "terraform", "meta": {"key": "AKIAEXAMPLE", "secret": "secret"}}`),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "id", "7"),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "type", "aws"),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "name", "terraform"),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "uuid", "5d1f0c3e-2b4a-4c8e-9f6d-0a1b2c3d4e5f"),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "role_arn", "arn:aws:iam::123456789012:role/laravel-vapor-role"),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "role_sync", "true"),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "network_limit", "10"),
+					resource.TestCheckNoResourceAttr("laravelvapor_cloud_provider.test", "concurrency"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "laravelvapor_cloud_provider.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Credentials and creation options are never returned by the API
+				ImportStateVerifyIgnore: []string{"key", "secret", "wait_for_role_sync", "wait_for_deletion"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCloudProviderResourceConfig(79169, "terraform-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_cloud_provider.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/providers/7", `{"name": "terraform-renamed", "role_sync": true, "network_limit": 10}`),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "name", "terraform-renamed"),
+				),
+			},
+			// Limits update testing
+			{
+				Config: testAccCloudProviderResourceConcurrencyConfig(100, 50),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_cloud_provider.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/providers/7", `{"name": "terraform-renamed", "role_sync": true, "network_limit": 10, "concurrency": 100, "unreserved_concurrency": 50}`),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "network_limit", "10"),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "concurrency", "100"),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "unreserved_concurrency", "50"),
+				),
+			},
+			// Moving to another team recreates the cloud provider
+			{
+				Config: testAccCloudProviderResourceConfig(79170, "terraform-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_cloud_provider.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("DELETE /api/providers/7", ""),
+					server.checkRequest("POST /api/teams/79170/providers", `{"type": "aws", "name": "terraform-renamed", "meta": {"key": "AKIAEXAMPLE", "secret": "secret"}}`),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "id", "8"),
+					resource.TestCheckResourceAttr("laravelvapor_cloud_provider.test", "team_id", "79170"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCloudProviderResourceConfig(teamId int, name string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_cloud_provider" "test" {
+  team_id            = %[1]d
+  type               = "aws"
+  name               = %[2]q
+  key                = "AKIAEXAMPLE"
+  secret             = "secret"
+  wait_for_role_sync = true
+  wait_for_deletion  = true
+}
+`, teamId, name)
+}
+
+func testAccCloudProviderResourceConcurrencyConfig(concurrency int, unreservedConcurrency int) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_cloud_provider" "test" {
+  team_id                = 79169
+  type                   = "aws"
+  name                   = "terraform-renamed"
+  key                    = "AKIAEXAMPLE"
+  secret                 = "secret"
+  network_limit          = 10
+  concurrency            = %[1]d
+  unreserved_concurrency = %[2]d
+  wait_for_role_sync     = true
+  wait_for_deletion      = true
+}
+`, concurrency, unreservedConcurrency)
+}
+
+const testAccCloudProviderResourceInvalidTypeConfig = `
+resource "laravelvapor_cloud_provider" "test" {
+  team_id = 79169
+  type    = "awz"
+  name    = "terraform"
+  key     = "AKIAEXAMPLE"
+  secret  = "secret"
+}
+`
+
+func TestCloudProviderResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &CloudProviderResource{}
+	schemaResp := fwresource.SchemaResponse{}
+
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := map[string]struct {
+		id        string
+		wantError bool
+	}{
+		"numeric":      {id: "7"},
+		"not a number": {id: "terraform", wantError: true},
+		"out of range": {id: "4294967297", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := fwresource.ImportStateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: test.id}, &resp)
+
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Fatalf("expected import error to be %t, got diagnostics: %v", test.wantError, resp.Diagnostics)
+			}
+
+			if test.wantError {
+				return
+			}
+
+			var id types.Int32
+
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+
+			if id.ValueInt32() != 7 {
+				t.Errorf("expected cloud provider ID 7, got %s", id)
+			}
+		})
+	}
+}
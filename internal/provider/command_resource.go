@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CommandResource{}
+
+func NewCommandResource() resource.Resource {
+	return &CommandResource{}
+}
+
+// CommandResource defines the resource implementation.
+type CommandResource struct {
+	client VaporClient
+}
+
+// CommandResourceModel describes the resource data model.
+type CommandResourceModel struct {
+	Id            types.Int32    `tfsdk:"id"`
+	EnvironmentId types.Int32    `tfsdk:"environment_id"`
+	Command       types.String   `tfsdk:"command"`
+	Triggers      types.Map      `tfsdk:"triggers"`
+	Status        types.String   `tfsdk:"status"`
+	ExitCode      types.Int64    `tfsdk:"exit_code"`
+	Output        types.String   `tfsdk:"output"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CommandResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_command"
+}
+
+func (r *CommandResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Run a CLI command in an environment once, creation waits until the command completes. Change `triggers` to run it again",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Command ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID the command runs in",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"command": schema.StringAttribute{
+				MarkdownDescription: "Command to run (e.g. `migrate --force`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that run the command again when changed",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Command status",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"exit_code": schema.Int64Attribute{
+				MarkdownDescription: "Command exit code",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"output": schema.StringAttribute{
+				MarkdownDescription: "Command output",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *CommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CommandResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	command, err := r.client.RunCommand(ctx, int(data.EnvironmentId.ValueInt32()), data.Command.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("run command", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(command.Id))
+
+	// Nothing was sent in dry run mode, there is nothing to wait for
+	if r.client.dryRun {
+		data.Status = types.StringValue(command.Status)
+		data.ExitCode = types.Int64Value(int64(command.ExitCode))
+		data.Output = types.StringValue(command.Output)
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	_, err = waitForStatus(waitCtx, defaultPollInterval, []string{"pending", "running"}, func() (string, error) {
+		command, err = r.client.GetCommand(waitCtx, int(data.Id.ValueInt32()))
+
+		return command.Status, err
+	})
+
+	data.Status = types.StringValue(command.Status)
+	data.ExitCode = types.Int64Value(int64(command.ExitCode))
+	data.Output = types.StringValue(command.Output)
+
+	// Command is kept in state even when it fails, so it is tainted and run again on next apply
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("wait for command", err, data.identity()))
+	} else if command.Status == "failed" || command.ExitCode != 0 {
+		resp.Diagnostics.AddError("Command Failed", fmt.Sprintf("Command exited with code %d:\n\n%s", command.ExitCode, command.Output))
+	}
+
+	tflog.Trace(ctx, "created a command resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CommandResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Commands only run once, the recorded result is kept as is
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CommandResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes require replacement, nothing to update upstream
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Commands cannot be undone, removing it from state is enough
+	tflog.Trace(ctx, "removed a command resource from state")
+}
+
+// identity returns the attributes identifying the command in diagnostics.
+func (data *CommandResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":             data.Id,
+		"environment_id": data.EnvironmentId,
+	}
+}
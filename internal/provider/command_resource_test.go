@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccCommandResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/environments/1/commands": `{"id": 51, "environment_id": 1, "command": "migrate --force", "status": "pending"}`,
+		"GET /api/commands/51":              `{"id": 51, "environment_id": 1, "command": "migrate --force", "status": "finished", "exit_code": 0, "output": "Nothing to migrate."}`,
+		"GET /api/commands/52":              `{"id": 52, "environment_id": 1, "command": "migrate --force", "status": "finished", "exit_code": 0, "output": "Migrated: 2024_01_01_000000_create_orders_table"}`,
+	})
+
+	// The command runs again as a new one
+	server.setRouteAfter("POST /api/environments/1/commands", "POST /api/environments/1/commands", `{"id": 52, "environment_id": 1, "command": "migrate --force", "status": "pending"}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCommandResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/environments/1/commands", `{"command": "migrate --force"}`),
+					resource.TestCheckResourceAttr("laravelvapor_command.test", "id", "51"),
+					resource.TestCheckResourceAttr("laravelvapor_command.test", "environment_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_command.test", "command", "migrate --force"),
+					resource.TestCheckResourceAttr("laravelvapor_command.test", "status", "finished"),
+					resource.TestCheckResourceAttr("laravelvapor_command.test", "exit_code", "0"),
+					resource.TestCheckResourceAttr("laravelvapor_command.test", "output", "Nothing to migrate."),
+				),
+			},
+			// Changing triggers runs the command again
+			{
+				Config: testAccCommandResourceConfig("2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_command.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("laravelvapor_command.test", "id", "52"),
+					resource.TestCheckResourceAttr("laravelvapor_command.test", "triggers.database", "2"),
+					resource.TestCheckResourceAttr("laravelvapor_command.test", "output", "Migrated: 2024_01_01_000000_create_orders_table"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCommandResourceConfig(trigger string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_command" "test" {
+  environment_id = 1
+  command        = "migrate --force"
+
+  triggers = {
+    database = %[1]q
+  }
+}
+`, trigger)
+}
@@ -1,5 +1,101 @@
 package provider
 
+import "time"
+
 const (
-	defaultApiHost = "https://vapor.laravel.com"
+	defaultApiHost        = "https://vapor.laravel.com"
+	defaultRequestTimeout = 30 * time.Second
+
+	defaultMaxRetries     = 3
+	defaultRetryBaseDelay = time.Second
+	maxRetryDelay         = 30 * time.Second
+
+	// maxConcurrentRequests bounds requests sent at once for batch operations without an API endpoint
+	maxConcurrentRequests = 4
+
+	errorBodySnippetLength = 200
+	debugBodyLength        = 4096
+
+	// maxDeploymentLogSize bounds the deployment log kept in memory and state, only its end is kept beyond it
+	maxDeploymentLogSize = 1 << 20
+
+	redactedValue = "[REDACTED]"
+
+	idempotencyKeyHeader = "Idempotency-Key"
+
+	defaultPollInterval  = 10 * time.Second
+	defaultCreateTimeout = 30 * time.Minute
+	defaultUpdateTimeout = 30 * time.Minute
+	defaultDeleteTimeout = 30 * time.Minute
+
+	// databaseScalingGracePeriod is how long a database still available after a scaling request is waited on
+	databaseScalingGracePeriod = 5 * time.Minute
+
+	defaultTokenName = "terraform"
+	defaultTokenTtl  = time.Hour
 )
+
+// sensitiveFields are request and response body fields never written to logs.
+var sensitiveFields = map[string]bool{
+	"token":    true,
+	"key":      true,
+	"secret":   true,
+	"password": true,
+	// Secret values are sent under this field, it also hides zone record values
+	"value": true,
+	// Slack and Discord notification destinations are webhook URLs embedding a secret
+	"destination": true,
+}
+
+// sensitiveObjects are body fields holding arbitrary names, all their values are never written to logs.
+var sensitiveObjects = map[string]bool{
+	// Environment variables and secrets are keyed by their own names
+	"variables": true,
+	"secrets":   true,
+}
+
+// cloudProviderTypes are the cloud provider types supported by Laravel Vapor.
+var cloudProviderTypes = []string{"aws"}
+
+// zoneRecordTypes are the DNS record types zone records can be created with.
+var zoneRecordTypes = []string{"A", "AAAA", "CAA", "CNAME", "MX", "NS", "PTR", "SPF", "SRV", "TXT"}
+
+// teamPermissions are the permissions team members can be granted, as offered by the Vapor CLI `member:add` command.
+var teamPermissions = []string{
+	"view-projects",
+	"create-projects",
+	"delete-projects",
+	"deploy-projects",
+	"view-resources",
+	"create-resources",
+	"delete-resources",
+	"view-providers",
+	"create-providers",
+	"delete-providers",
+}
+
+// deploymentPendingStatuses are the statuses of a deployment until it finishes or fails.
+var deploymentPendingStatuses = []string{"pending", "building", "deploying"}
+
+// databaseScalingStatuses are the statuses of a database while its instance is modified.
+var databaseScalingStatuses = []string{"scaling", "modifying", "updating"}
+
+// alarmOperators are the CloudWatch comparison operators alarms can be created with.
+var alarmOperators = []string{
+	"GreaterThanOrEqualToThreshold",
+	"GreaterThanThreshold",
+	"LessThanThreshold",
+	"LessThanOrEqualToThreshold",
+}
+
+// notificationTypes are the channels team notifications can be sent through.
+var notificationTypes = []string{"slack", "discord", "email", "webhook"}
+
+// notificationEvents are the events team notifications can be subscribed to.
+var notificationEvents = []string{
+	"deployment.started",
+	"deployment.succeeded",
+	"deployment.failed",
+	"alarm.triggered",
+	"alarm.resolved",
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatabaseDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/databases/1": `{"id": 1, "team_id": 79169, "name": "production", "type": "rds", "region": "us-east-1", "instance_class": "db.t3.micro", "status": "available", "endpoint": "production.abc123.us-east-1.rds.amazonaws.com", "port": 3306}`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDatabaseDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_database.test", "id", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_database.test", "name", "production"),
+					resource.TestCheckResourceAttr("data.laravelvapor_database.test", "status", "available"),
+					resource.TestCheckResourceAttr("data.laravelvapor_database.test", "endpoint", "production.abc123.us-east-1.rds.amazonaws.com"),
+					resource.TestCheckResourceAttr("data.laravelvapor_database.test", "port", "3306"),
+					resource.TestCheckResourceAttr("data.laravelvapor_database.test", "instance_class", "db.t3.micro"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatabaseDataSourceConfig = `
+data "laravelvapor_database" "test" {
+  id = 1
+}
+`
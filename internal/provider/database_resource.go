@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseResource{}
+
+func NewDatabaseResource() resource.Resource {
+	return &DatabaseResource{}
+}
+
+// DatabaseResource defines the resource implementation.
+type DatabaseResource struct {
+	client VaporClient
+}
+
+// DatabaseResourceModel describes the resource data model.
+type DatabaseResourceModel struct {
+	Id              types.Int32    `tfsdk:"id"`
+	TeamId          types.Int32    `tfsdk:"team_id"`
+	Name            types.String   `tfsdk:"name"`
+	Type            types.String   `tfsdk:"type"`
+	Region          types.String   `tfsdk:"region"`
+	CloudProviderId types.Int32    `tfsdk:"cloud_provider_id"`
+	InstanceClass   types.String   `tfsdk:"instance_class"`
+	Status          types.String   `tfsdk:"status"`
+	Endpoint        types.String   `tfsdk:"endpoint"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a RDS database, creation and scaling wait until the database is available",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Database ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID the database belongs to, defaults to the provider `team_id`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Database name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Database type (e.g. `rds`, `aurora-serverless`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Database AWS region (e.g. `us-east-1`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_provider_id": schema.Int32Attribute{
+				MarkdownDescription: "Cloud provider ID the database is created in",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"instance_class": schema.StringAttribute{
+				MarkdownDescription: "Database instance class (e.g. `db.t3.micro`), changing it scales the database in place",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Database status",
+				Computed:            true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Database endpoint host",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *DatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId, diags := teamIdOrDefault(data.TeamId, r.client)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamId = teamId
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.CreateDatabase(ctx, int(data.TeamId.ValueInt32()), VaporDatabase{
+		CloudProviderId: FlexInt(data.CloudProviderId.ValueInt32()),
+		Name:            data.Name.ValueString(),
+		Type:            data.Type.ValueString(),
+		Region:          data.Region.ValueString(),
+		InstanceClass:   data.InstanceClass.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create database", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(database.Id))
+
+	// Nothing was sent in dry run mode, there is nothing to wait for
+	if r.client.dryRun {
+		data.setComputed(database)
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	_, err = waitForStatus(waitCtx, defaultPollInterval, []string{"creating"}, func() (string, error) {
+		database, err = r.client.GetDatabase(waitCtx, int(data.Id.ValueInt32()))
+
+		return database.Status, err
+	})
+
+	data.setComputed(database)
+
+	// Database is kept in state even when provisioning fails, so it is tainted instead of leaked
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("wait for database creation", err, data.identity()))
+	} else if database.Status != "available" {
+		resp.Diagnostics.AddError("Database Creation Failed", fmt.Sprintf("Database creation ended with status %q instead of available.", database.Status))
+	}
+
+	tflog.Trace(ctx, "created a database resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, int(data.Id.ValueInt32()))
+
+	// Database was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read database", err, data.identity()))
+		return
+	}
+
+	if database.InstanceClass != "" {
+		data.InstanceClass = types.StringValue(database.InstanceClass)
+	}
+
+	data.setComputed(database)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DatabaseResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only timeouts changed, nothing to update upstream
+	if data.InstanceClass.Equal(state.InstanceClass) {
+		data.Status = state.Status
+		data.Endpoint = state.Endpoint
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the instance class can change without replacement, replacing would destroy the data
+	database, err := r.client.ScaleDatabase(ctx, int(data.Id.ValueInt32()), data.InstanceClass.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("scale database", err, data.identity()))
+		return
+	}
+
+	// Nothing was sent in dry run mode, the prior status is kept
+	if r.client.dryRun {
+		data.Status = state.Status
+		data.Endpoint = state.Endpoint
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	refresh := func() (string, error) {
+		database, err = r.client.GetDatabase(waitCtx, int(data.Id.ValueInt32()))
+
+		return database.Status, err
+	}
+
+	_, err = waitForStatus(waitCtx, defaultPollInterval, databaseScalingStatuses, databaseScalingRefresh(database.Status, databaseScalingGracePeriod, refresh))
+
+	data.setComputed(database)
+
+	// Scaled instance class is saved even when the wait fails, the database is modified upstream anyway
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("wait for database scaling", err, data.identity()))
+	} else if database.Status != "available" {
+		resp.Diagnostics.AddError("Database Scaling Failed", fmt.Sprintf("Database scaling ended with status %q instead of available.", database.Status))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveDatabase(ctx, int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete database", err, data.identity()))
+		return
+	}
+}
+
+// databaseScalingRefresh wraps a database status refresh for the scaling wait, starting from the scaling request status.
+// The API might not report the scaling right away, so an available database is reported as scaling
+// until a scaling status was seen or the grace period is over.
+func databaseScalingRefresh(initialStatus string, gracePeriod time.Duration, refresh func() (string, error)) func() (string, error) {
+	started := slices.Contains(databaseScalingStatuses, initialStatus)
+	graceEnd := time.Now().Add(gracePeriod)
+
+	return func() (string, error) {
+		status, err := refresh()
+
+		if err != nil {
+			return status, err
+		}
+
+		if slices.Contains(databaseScalingStatuses, status) {
+			started = true
+		}
+
+		if status == "available" && !started && time.Now().Before(graceEnd) {
+			return databaseScalingStatuses[0], nil
+		}
+
+		return status, nil
+	}
+}
+
+func (data *DatabaseResourceModel) setComputed(database *VaporDatabase) {
+	data.Status = types.StringValue(database.Status)
+
+	// Endpoint is only known once the database is provisioned
+	if database.Endpoint != "" || data.Endpoint.IsUnknown() {
+		data.Endpoint = types.StringValue(database.Endpoint)
+	}
+}
+
+// identity returns the attributes identifying the database in diagnostics.
+func (data *DatabaseResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":      data.Id,
+		"team_id": data.TeamId,
+		"name":    data.Name,
+	}
+}
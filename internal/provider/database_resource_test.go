@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDatabaseResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/teams/79169/databases": `{"id": 17, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "type": "rds", "region": "us-east-1", "instance_class": "db.t3.micro", "status": "creating"}`,
+		"GET /api/databases/17":           `{"id": 17, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "type": "rds", "region": "us-east-1", "instance_class": "db.t3.micro", "status": "available", "endpoint": "terraform.abc123.us-east-1.rds.amazonaws.com", "port": 3306}`,
+		"PUT /api/databases/17":           `{"id": 17, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "type": "rds", "region": "us-east-1", "instance_class": "db.t3.small", "status": "modifying", "endpoint": "terraform.abc123.us-east-1.rds.amazonaws.com", "port": 3306}`,
+		"DELETE /api/databases/17":        ``,
+	})
+
+	server.setRouteAfter("PUT /api/databases/17", "GET /api/databases/17", `{"id": 17, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "type": "rds", "region": "us-east-1", "instance_class": "db.t3.small", "status": "available", "endpoint": "terraform.abc123.us-east-1.rds.amazonaws.com", "port": 3306}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabaseResourceConfig("db.t3.micro"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/teams/79169/databases", `{"cloud_provider_id": 1, "name": "terraform", "type": "rds", "region": "us-east-1", "instance_class": "db.t3.micro"}`),
+					resource.TestCheckResourceAttr("laravelvapor_database.test", "id", "17"),
+					resource.TestCheckResourceAttr("laravelvapor_database.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("laravelvapor_database.test", "name", "terraform"),
+					resource.TestCheckResourceAttr("laravelvapor_database.test", "instance_class", "db.t3.micro"),
+					resource.TestCheckResourceAttr("laravelvapor_database.test", "status", "available"),
+					resource.TestCheckResourceAttr("laravelvapor_database.test", "endpoint", "terraform.abc123.us-east-1.rds.amazonaws.com"),
+				),
+			},
+			// Scaling testing
+			{
+				Config: testAccDatabaseResourceConfig("db.t3.small"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_database.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/databases/17", `{"instance_class": "db.t3.small"}`),
+					resource.TestCheckResourceAttr("laravelvapor_database.test", "instance_class", "db.t3.small"),
+					resource.TestCheckResourceAttr("laravelvapor_database.test", "status", "available"),
+				),
+			},
+			// Timeouts only update testing, the database is not scaled again
+			{
+				Config: testAccDatabaseResourceTimeoutsConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_database.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequestCount("PUT /api/databases/17", 1),
+					resource.TestCheckResourceAttr("laravelvapor_database.test", "instance_class", "db.t3.small"),
+					resource.TestCheckResourceAttr("laravelvapor_database.test", "status", "available"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDatabaseResourceConfig(instanceClass string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_database" "test" {
+  team_id           = 79169
+  name              = "terraform"
+  type              = "rds"
+  region            = "us-east-1"
+  cloud_provider_id = 1
+  instance_class    = %[1]q
+
+  timeouts = {
+    create = "45m"
+  }
+}
+`, instanceClass)
+}
+
+const testAccDatabaseResourceTimeoutsConfig = `
+resource "laravelvapor_database" "test" {
+  team_id           = 79169
+  name              = "terraform"
+  type              = "rds"
+  region            = "us-east-1"
+  cloud_provider_id = 1
+  instance_class    = "db.t3.small"
+
+  timeouts = {
+    create = "45m"
+    update = "1h"
+  }
+}
+`
+
+func TestDatabaseResourceCreateDryRun(t *testing.T) {
+	ctx := context.Background()
+
+	// Unknown routes fail the test, the created database must not be polled
+	server := newTestServer(t, testRoutes{})
+
+	r := &DatabaseResource{client: VaporClient{apiHost: server.URL, dryRun: true}}
+	schemaResp := fwresource.SchemaResponse{}
+
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	for name, value := range map[string]attr.Value{
+		"id":                types.Int32Unknown(),
+		"team_id":           types.Int32Value(79169),
+		"name":              types.StringValue("terraform"),
+		"type":              types.StringValue("rds"),
+		"region":            types.StringValue("us-east-1"),
+		"cloud_provider_id": types.Int32Value(1),
+		"instance_class":    types.StringValue("db.t3.micro"),
+		"status":            types.StringUnknown(),
+		"endpoint":          types.StringUnknown(),
+	} {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	}
+
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw.Copy()}}
+
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data DatabaseResourceModel
+
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+	if data.Status.IsUnknown() || data.Endpoint.IsUnknown() || data.Id.IsUnknown() {
+		t.Errorf("expected computed values to be known, got %+v", data)
+	}
+}
+
+func TestDatabaseScalingRefresh(t *testing.T) {
+	tests := map[string]struct {
+		initialStatus string
+		gracePeriod   time.Duration
+		statuses      []string
+		expected      int
+	}{
+		"available until scaling starts": {initialStatus: "available", gracePeriod: time.Hour, statuses: []string{"available", "modifying", "available"}, expected: 3},
+		"scaling reported on request":    {initialStatus: "scaling", gracePeriod: time.Hour, statuses: []string{"available"}, expected: 1},
+		"grace period over":              {initialStatus: "available", gracePeriod: 0, statuses: []string{"available"}, expected: 1},
+		"failed before scaling starts":   {initialStatus: "available", gracePeriod: time.Hour, statuses: []string{"failed"}, expected: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+
+			refresh := databaseScalingRefresh(test.initialStatus, test.gracePeriod, func() (string, error) {
+				status := test.statuses[min(calls, len(test.statuses)-1)]
+				calls++
+
+				return status, nil
+			})
+
+			status, err := waitForStatus(context.Background(), time.Millisecond, databaseScalingStatuses, refresh)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if calls != test.expected || status != test.statuses[len(test.statuses)-1] {
+				t.Errorf("expected the wait to end after %d polls with status %q, got %d polls with status %q", test.expected, test.statuses[len(test.statuses)-1], calls, status)
+			}
+		})
+	}
+}
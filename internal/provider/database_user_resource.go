@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseUserResource{}
+
+func NewDatabaseUserResource() resource.Resource {
+	return &DatabaseUserResource{}
+}
+
+// DatabaseUserResource defines the resource implementation.
+type DatabaseUserResource struct {
+	client VaporClient
+}
+
+// DatabaseUserResourceModel describes the resource data model.
+type DatabaseUserResourceModel struct {
+	Id         types.Int32  `tfsdk:"id"`
+	DatabaseId types.Int32  `tfsdk:"database_id"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+}
+
+func (r *DatabaseUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_user"
+}
+
+func (r *DatabaseUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a user of a RDS database. The password is only returned by the API on creation, it is kept in state from then on",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Database user ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"database_id": schema.Int32Attribute{
+				MarkdownDescription: "Database ID the user belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Database user name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Database user password",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DatabaseUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabaseUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabaseUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.CreateDatabaseUser(ctx, int(data.DatabaseId.ValueInt32()), data.Username.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create database user", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(user.Id))
+	data.Password = types.StringValue(user.Password)
+
+	tflog.Trace(ctx, "created a database user resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabaseUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := r.client.GetDatabaseUsers(ctx, int(data.DatabaseId.ValueInt32()))
+
+	// Database was removed outside of Terraform, along with its users
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read database users", err, data.identity()))
+		return
+	}
+
+	var user *VaporDatabaseUser
+
+	for i := range users {
+		if users[i].Id == FlexInt(data.Id.ValueInt32()) {
+			user = &users[i]
+			break
+		}
+	}
+
+	// Database user was removed outside of Terraform
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Password is never returned again, the one saved on creation is kept
+	data.Username = types.StringValue(user.Username)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DatabaseUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes require replacement, nothing to update upstream
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabaseUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveDatabaseUser(ctx, int(data.DatabaseId.ValueInt32()), int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete database user", err, data.identity()))
+		return
+	}
+}
+
+// identity returns the attributes identifying the database user in diagnostics.
+func (data *DatabaseUserResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":          data.Id,
+		"database_id": data.DatabaseId,
+		"username":    data.Username,
+	}
+}
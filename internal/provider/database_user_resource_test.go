@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDatabaseUserResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/databases/1/users":     `{"id": 3, "database_id": 1, "username": "billing", "password": "billing-password"}`,
+		"GET /api/databases/1/users":      `[]`,
+		"DELETE /api/databases/1/users/3": ``,
+		"DELETE /api/databases/1/users/4": ``,
+	})
+
+	// Changing the username creates another user, passwords are only returned on creation
+	server.setRouteAfter("POST /api/databases/1/users", "POST /api/databases/1/users", `{"id": 4, "database_id": 1, "username": "invoicing", "password": "invoicing-password"}`)
+	server.setRouteAfter("POST /api/databases/1/users", "GET /api/databases/1/users", `[{"id": 3, "database_id": 1, "username": "billing"}]`)
+	server.setRouteAfter("POST /api/databases/1/users", "GET /api/databases/1/users", `[{"id": 4, "database_id": 1, "username": "invoicing"}]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabaseUserResourceConfig("billing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/databases/1/users", `{"username": "billing"}`),
+					resource.TestCheckResourceAttr("laravelvapor_database_user.test", "id", "3"),
+					resource.TestCheckResourceAttr("laravelvapor_database_user.test", "database_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_database_user.test", "username", "billing"),
+					resource.TestCheckResourceAttr("laravelvapor_database_user.test", "password", "billing-password"),
+				),
+			},
+			// Username change testing
+			{
+				Config: testAccDatabaseUserResourceConfig("invoicing"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_database_user.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("DELETE /api/databases/1/users/3", ""),
+					server.checkRequest("POST /api/databases/1/users", `{"username": "invoicing"}`),
+					resource.TestCheckResourceAttr("laravelvapor_database_user.test", "id", "4"),
+					resource.TestCheckResourceAttr("laravelvapor_database_user.test", "username", "invoicing"),
+					resource.TestCheckResourceAttr("laravelvapor_database_user.test", "password", "invoicing-password"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDatabaseUserResourceConfig(username string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_database_user" "test" {
+  database_id = 1
+  username    = %[1]q
+}
+`, username)
+}
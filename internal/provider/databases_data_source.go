@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DatabasesDataSource{}
+
+func NewDatabasesDataSource() datasource.DataSource {
+	return &DatabasesDataSource{}
+}
+
+// DatabasesDataSource defines the data source implementation.
+type DatabasesDataSource struct {
+	client VaporClient
+}
+
+// DatabasesDataSourceModel describes the data source data model.
+type DatabasesDataSourceModel struct {
+	TeamId    types.Int32 `tfsdk:"team_id"`
+	Databases types.List  `tfsdk:"databases"`
+}
+
+// DatabaseModel describes a database object in data source models.
+type DatabaseModel struct {
+	Id            types.Int32  `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Status        types.String `tfsdk:"status"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+	Port          types.Int32  `tfsdk:"port"`
+	InstanceClass types.String `tfsdk:"instance_class"`
+}
+
+var databaseAttrTypes = map[string]attr.Type{
+	"id":             types.Int32Type,
+	"name":           types.StringType,
+	"status":         types.StringType,
+	"endpoint":       types.StringType,
+	"port":           types.Int32Type,
+	"instance_class": types.StringType,
+}
+
+func newDatabaseModel(database VaporDatabase) DatabaseModel {
+	return DatabaseModel{
+		Id:            types.Int32Value(int32(database.Id)),
+		Name:          types.StringValue(database.Name),
+		Status:        types.StringValue(database.Status),
+		Endpoint:      types.StringValue(database.Endpoint),
+		Port:          types.Int32Value(int32(database.Port)),
+		InstanceClass: types.StringValue(database.InstanceClass),
+	}
+}
+
+func (d *DatabasesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_databases"
+}
+
+func (d *DatabasesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "List all RDS databases of a team",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID",
+				Required:            true,
+			},
+			"databases": schema.ListNestedAttribute{
+				MarkdownDescription: "Databases list, ordered by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Database ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Database name",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Database status",
+							Computed:            true,
+						},
+						"endpoint": schema.StringAttribute{
+							MarkdownDescription: "Database host, empty until the database is provisioned",
+							Computed:            true,
+						},
+						"port": schema.Int32Attribute{
+							MarkdownDescription: "Database port",
+							Computed:            true,
+						},
+						"instance_class": schema.StringAttribute{
+							MarkdownDescription: "Database RDS instance class",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DatabasesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatabasesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databases, err := d.client.GetDatabases(ctx, int(data.TeamId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read databases, got error: %s", err))
+		return
+	}
+
+	// Keep a stable order between reads to prevent spurious diffs
+	sort.SliceStable(databases, func(i, j int) bool {
+		return databases[i].Id < databases[j].Id
+	})
+
+	databaseModels := []DatabaseModel{}
+
+	for _, database := range databases {
+		databaseModels = append(databaseModels, newDatabaseModel(database))
+	}
+
+	databasesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: databaseAttrTypes}, databaseModels)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Databases = databasesValue
+
+	tflog.Trace(ctx, "read databases data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatabasesDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/teams/79169/databases": `[{"id": 1, "team_id": 79169, "name": "production", "type": "rds", "instance_class": "db.t3.micro", "status": "available", "endpoint": "production.abc123.us-east-1.rds.amazonaws.com", "port": 3306}, {"id": 2, "team_id": 79169, "name": "staging", "type": "rds", "instance_class": "db.t3.micro", "status": "creating"}]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDatabasesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_databases.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("data.laravelvapor_databases.test", "databases.#", "2"),
+					resource.TestCheckResourceAttr("data.laravelvapor_databases.test", "databases.0.id", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_databases.test", "databases.0.name", "production"),
+					resource.TestCheckResourceAttr("data.laravelvapor_databases.test", "databases.0.endpoint", "production.abc123.us-east-1.rds.amazonaws.com"),
+					resource.TestCheckResourceAttr("data.laravelvapor_databases.test", "databases.0.port", "3306"),
+					resource.TestCheckResourceAttr("data.laravelvapor_databases.test", "databases.1.name", "staging"),
+					resource.TestCheckResourceAttr("data.laravelvapor_databases.test", "databases.1.status", "creating"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatabasesDataSourceConfig = `
+data "laravelvapor_databases" "test" {
+  team_id = 79169
+}
+`
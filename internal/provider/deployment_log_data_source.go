@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DeploymentLogDataSource{}
+
+func NewDeploymentLogDataSource() datasource.DataSource {
+	return &DeploymentLogDataSource{}
+}
+
+// DeploymentLogDataSource defines the data source implementation.
+type DeploymentLogDataSource struct {
+	client VaporClient
+}
+
+// DeploymentLogDataSourceModel describes the data source data model.
+type DeploymentLogDataSourceModel struct {
+	DeploymentId types.Int32  `tfsdk:"deployment_id"`
+	Log          types.String `tfsdk:"log"`
+	Truncated    types.Bool   `tfsdk:"truncated"`
+}
+
+func (d *DeploymentLogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_log"
+}
+
+func (d *DeploymentLogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Get the build and deployment output of a deployment",
+
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.Int32Attribute{
+				MarkdownDescription: "Deployment ID",
+				Required:            true,
+			},
+			"log": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Deployment log, only its last %d bytes are kept for longer logs, preceded by a note", maxDeploymentLogSize),
+				Computed:            true,
+			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Was the beginning of the log dropped as it exceeded the size limit",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DeploymentLogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentLogDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	log, truncated, err := d.client.GetDeploymentLog(ctx, int(data.DeploymentId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment log, got error: %s", err))
+		return
+	}
+
+	if truncated {
+		log = fmt.Sprintf("[Deployment log truncated, only its last %d bytes are kept]\n", maxDeploymentLogSize) + log
+	}
+
+	data.Log = types.StringValue(log)
+	data.Truncated = types.BoolValue(truncated)
+
+	tflog.Trace(ctx, "read deployment log data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
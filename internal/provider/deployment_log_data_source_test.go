@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentLogDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/deployments/1/log": "Building project...\nDeploying environment...\n",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDeploymentLogDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_deployment_log.test", "deployment_id", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_deployment_log.test", "truncated", "false"),
+					resource.TestCheckResourceAttr("data.laravelvapor_deployment_log.test", "log", "Building project...\nDeploying environment...\n"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDeploymentLogDataSourceConfig = `
+data "laravelvapor_deployment_log" "test" {
+  deployment_id = 1
+}
+`
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeploymentResource{}
+
+func NewDeploymentResource() resource.Resource {
+	return &DeploymentResource{}
+}
+
+// DeploymentResource defines the resource implementation.
+type DeploymentResource struct {
+	client VaporClient
+}
+
+// DeploymentResourceModel describes the resource data model.
+type DeploymentResourceModel struct {
+	Id            types.Int32    `tfsdk:"id"`
+	EnvironmentId types.Int32    `tfsdk:"environment_id"`
+	Commit        types.String   `tfsdk:"commit"`
+	Status        types.String   `tfsdk:"status"`
+	Url           types.String   `tfsdk:"url"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Trigger a deployment of an environment, creation waits until the deployment finishes. Destroying it only removes it from state as deployments are kept as history",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Deployment ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID to deploy",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Commit hash to deploy, a change triggers a new deployment",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Deployment status",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Deployment URL",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *DeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DeploymentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.client.TriggerDeployment(ctx, int(data.EnvironmentId.ValueInt32()), data.Commit.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("trigger deployment", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(deployment.Id))
+
+	// Nothing was sent in dry run mode, there is nothing to wait for
+	if r.client.dryRun {
+		data.setComputed(deployment)
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	_, err = waitForStatus(waitCtx, defaultPollInterval, deploymentPendingStatuses, func() (string, error) {
+		deployment, err = r.client.GetDeployment(waitCtx, int(data.Id.ValueInt32()))
+
+		return deployment.Status, err
+	})
+
+	data.setComputed(deployment)
+
+	// Deployment is kept in state even when it fails, so it is tainted and triggered again on next apply
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("wait for deployment", err, data.identity()))
+	} else if deployment.Status == "failed" {
+		resp.Diagnostics.AddError("Deployment Failed", fmt.Sprintf("Deployment %d of environment %d failed.", deployment.Id, data.EnvironmentId.ValueInt32()))
+	}
+
+	tflog.Trace(ctx, "created a deployment resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DeploymentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.client.GetDeployment(ctx, int(data.Id.ValueInt32()))
+
+	// Deployment was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read deployment", err, data.identity()))
+		return
+	}
+
+	data.setComputed(deployment)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DeploymentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes require replacement, nothing to update upstream
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Deployments are immutable history, removing it from state is enough
+	tflog.Trace(ctx, "removed a deployment resource from state")
+}
+
+func (data *DeploymentResourceModel) setComputed(deployment *VaporDeployment) {
+	data.Status = types.StringValue(deployment.Status)
+	data.Url = types.StringValue(deployment.Url)
+}
+
+// identity returns the attributes identifying the deployment in diagnostics.
+func (data *DeploymentResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":             data.Id,
+		"environment_id": data.EnvironmentId,
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDeploymentResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/environments/1/deployments": `{"id": 301, "environment_id": 1, "commit_hash": "a1b2c3d", "status": "pending"}`,
+		"GET /api/deployments/301":             `{"id": 301, "environment_id": 1, "commit_hash": "a1b2c3d", "status": "finished", "url": "https://terraform-staging.vapor-farm-a1.com"}`,
+		"GET /api/deployments/302":             `{"id": 302, "environment_id": 1, "commit_hash": "e4f5a6b", "status": "finished", "url": "https://terraform-staging.vapor-farm-a1.com"}`,
+	})
+
+	// Every commit is deployed as a new deployment
+	server.setRouteAfter("POST /api/environments/1/deployments", "POST /api/environments/1/deployments", `{"id": 302, "environment_id": 1, "commit_hash": "e4f5a6b", "status": "pending"}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDeploymentResourceConfig("a1b2c3d"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/environments/1/deployments", `{"commit": "a1b2c3d"}`),
+					resource.TestCheckResourceAttr("laravelvapor_deployment.test", "id", "301"),
+					resource.TestCheckResourceAttr("laravelvapor_deployment.test", "environment_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_deployment.test", "commit", "a1b2c3d"),
+					resource.TestCheckResourceAttr("laravelvapor_deployment.test", "status", "finished"),
+					resource.TestCheckResourceAttr("laravelvapor_deployment.test", "url", "https://terraform-staging.vapor-farm-a1.com"),
+				),
+			},
+			// A new commit triggers a new deployment
+			{
+				Config: testAccDeploymentResourceConfig("e4f5a6b"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_deployment.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/environments/1/deployments", `{"commit": "e4f5a6b"}`),
+					resource.TestCheckResourceAttr("laravelvapor_deployment.test", "id", "302"),
+					resource.TestCheckResourceAttr("laravelvapor_deployment.test", "commit", "e4f5a6b"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDeploymentResourceConfig(commit string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_deployment" "test" {
+  environment_id = 1
+  commit         = %[1]q
+}
+`, commit)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &DeploymentTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &DeploymentTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &DeploymentTokenEphemeralResource{}
+
+// deploymentTokenPrivateKey is the private data key holding the token ID between Open and Close.
+const deploymentTokenPrivateKey = "token"
+
+func NewDeploymentTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &DeploymentTokenEphemeralResource{}
+}
+
+// DeploymentTokenEphemeralResource defines the ephemeral resource implementation.
+type DeploymentTokenEphemeralResource struct {
+	client VaporClient
+}
+
+// DeploymentTokenEphemeralResourceModel describes the ephemeral resource data model.
+type DeploymentTokenEphemeralResourceModel struct {
+	TeamId    types.Int32  `tfsdk:"team_id"`
+	Name      types.String `tfsdk:"name"`
+	Ttl       types.Int64  `tfsdk:"ttl"`
+	Token     types.String `tfsdk:"token"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+// deploymentTokenPrivateData describes the private data kept between Open and Close.
+type deploymentTokenPrivateData struct {
+	Id int `json:"id"`
+}
+
+func (r *DeploymentTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_token"
+}
+
+func (r *DeploymentTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Get a short-lived team API token for the current run, it is never stored in state and revoked once Terraform is done with it",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID the token is scoped to, defaults to the provider `team_id`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Token name (defaults to `terraform`)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "Token lifetime in seconds (defaults to 3600)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "API token",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Token expiration date",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DeploymentTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data DeploymentTokenEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId, diags := teamIdOrDefault(data.TeamId, r.client)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamId = teamId
+
+	if data.Name.IsNull() {
+		data.Name = types.StringValue(defaultTokenName)
+	}
+
+	if data.Ttl.IsNull() {
+		data.Ttl = types.Int64Value(int64(defaultTokenTtl.Seconds()))
+	}
+
+	if data.Ttl.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ttl"),
+			"Invalid Token TTL",
+			"The token TTL must be a positive number of seconds.",
+		)
+
+		return
+	}
+
+	token, err := r.client.CreateTeamToken(ctx, int(data.TeamId.ValueInt32()), data.Name.ValueString(), data.Ttl.ValueInt64())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create deployment token, got error: %s", err))
+		return
+	}
+
+	data.Token = types.StringValue(token.Token)
+	data.ExpiresAt = types.StringValue(token.ExpiresAt)
+
+	privateData, _ := json.Marshal(deploymentTokenPrivateData{Id: int(token.Id)})
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, deploymentTokenPrivateKey, privateData)...)
+
+	tflog.Trace(ctx, "opened a deployment token ephemeral resource")
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *DeploymentTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, deploymentTokenPrivateKey)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var privateData deploymentTokenPrivateData
+
+	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
+		resp.Diagnostics.AddError("Unexpected Private Data", fmt.Sprintf("Unable to read deployment token private data, got error: %s", err))
+		return
+	}
+
+	err := r.client.RemoveToken(ctx, privateData.Id)
+
+	// Already expired or revoked outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke deployment token, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "closed a deployment token ephemeral resource")
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DeploymentsDataSource{}
+
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+// DeploymentsDataSource defines the data source implementation.
+type DeploymentsDataSource struct {
+	client VaporClient
+}
+
+// DeploymentsDataSourceModel describes the data source data model.
+type DeploymentsDataSourceModel struct {
+	EnvironmentId types.Int32 `tfsdk:"environment_id"`
+	Deployments   types.List  `tfsdk:"deployments"`
+}
+
+// DeploymentModel describes a deployment object in data source models.
+type DeploymentModel struct {
+	Id         types.Int32  `tfsdk:"id"`
+	Status     types.String `tfsdk:"status"`
+	CommitHash types.String `tfsdk:"commit_hash"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+var deploymentAttrTypes = map[string]attr.Type{
+	"id":          types.Int32Type,
+	"status":      types.StringType,
+	"commit_hash": types.StringType,
+	"created_at":  types.StringType,
+}
+
+func (d *DeploymentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+func (d *DeploymentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "List deployments of an environment",
+
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID",
+				Required:            true,
+			},
+			"deployments": schema.ListNestedAttribute{
+				MarkdownDescription: "Deployments list, newest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Deployment ID",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Deployment status",
+							Computed:            true,
+						},
+						"commit_hash": schema.StringAttribute{
+							MarkdownDescription: "Deployed commit hash",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Deployment creation date",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeploymentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployments, err := d.client.GetDeployments(ctx, int(data.EnvironmentId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployments, got error: %s", err))
+		return
+	}
+
+	// IDs always increase, so the latest deployment comes first
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return deployments[i].Id > deployments[j].Id
+	})
+
+	deploymentModels := []DeploymentModel{}
+
+	for _, deployment := range deployments {
+		deploymentModels = append(deploymentModels, DeploymentModel{
+			Id:         types.Int32Value(int32(deployment.Id)),
+			Status:     types.StringValue(deployment.Status),
+			CommitHash: types.StringValue(deployment.CommitHash),
+			CreatedAt:  types.StringValue(deployment.CreatedAt),
+		})
+	}
+
+	deploymentsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: deploymentAttrTypes}, deploymentModels)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Deployments = deploymentsValue
+
+	tflog.Trace(ctx, "read deployments data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeploymentsDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/environments/1/deployments": `[{"id": 302, "environment_id": 1, "commit_hash": "e4f5a6b", "status": "finished", "created_at": "2024-05-02T10:00:00.000000Z"}, {"id": 301, "environment_id": 1, "commit_hash": "a1b2c3d", "status": "failed", "created_at": "2024-05-01T10:00:00.000000Z"}]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDeploymentsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_deployments.test", "environment_id", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_deployments.test", "deployments.#", "2"),
+					resource.TestCheckResourceAttr("data.laravelvapor_deployments.test", "deployments.0.id", "302"),
+					resource.TestCheckResourceAttr("data.laravelvapor_deployments.test", "deployments.0.commit_hash", "e4f5a6b"),
+					resource.TestCheckResourceAttr("data.laravelvapor_deployments.test", "deployments.0.created_at", "2024-05-02T10:00:00.000000Z"),
+					resource.TestCheckResourceAttr("data.laravelvapor_deployments.test", "deployments.1.id", "301"),
+					resource.TestCheckResourceAttr("data.laravelvapor_deployments.test", "deployments.1.status", "failed"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDeploymentsDataSourceConfig = `
+data "laravelvapor_deployments" "test" {
+  environment_id = 1
+}
+`
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DomainResource{}
+
+func NewDomainResource() resource.Resource {
+	return &DomainResource{}
+}
+
+// DomainResource defines the resource implementation.
+type DomainResource struct {
+	client VaporClient
+}
+
+// DomainResourceModel describes the resource data model.
+type DomainResourceModel struct {
+	Id            types.Int32  `tfsdk:"id"`
+	EnvironmentId types.Int32  `tfsdk:"environment_id"`
+	Domain        types.String `tfsdk:"domain"`
+	Status        types.String `tfsdk:"status"`
+}
+
+func (r *DomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a custom domain attached to an environment",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Domain ID",
+				Computed:            true,
+			},
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID the domain is attached to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Domain status",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := r.client.CreateDomain(ctx, int(data.EnvironmentId.ValueInt32()), data.Domain.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create domain", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(domain.Id))
+	data.Status = types.StringValue(domain.Status)
+
+	tflog.Trace(ctx, "created a domain resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains, err := r.client.GetDomains(ctx, int(data.EnvironmentId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read domains", err, data.identity()))
+		return
+	}
+
+	var domain *VaporDomain
+
+	for i := range domains {
+		if domains[i].Id == FlexInt(data.Id.ValueInt32()) {
+			domain = &domains[i]
+			break
+		}
+	}
+
+	// Domain was removed outside of Terraform
+	if domain == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Domain = types.StringValue(domain.Domain)
+	data.Status = types.StringValue(domain.Status)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes require replacement, nothing to update upstream
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveDomain(ctx, int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete domain", err, data.identity()))
+		return
+	}
+}
+
+// identity returns the attributes identifying the domain in diagnostics.
+func (data *DomainResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":             data.Id,
+		"environment_id": data.EnvironmentId,
+		"domain":         data.Domain,
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDomainResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/environments/1/domains": `{"id": 61, "environment_id": 1, "domain": "app.example.com", "status": "pending"}`,
+		"GET /api/environments/1/domains":  `[]`,
+		"DELETE /api/domains/61":           ``,
+		"DELETE /api/domains/62":           ``,
+	})
+
+	server.setRouteAfter("POST /api/environments/1/domains", "POST /api/environments/1/domains", `{"id": 62, "environment_id": 1, "domain": "www.example.com", "status": "pending"}`)
+	server.setRouteAfter("POST /api/environments/1/domains", "GET /api/environments/1/domains", `[{"id": 61, "environment_id": 1, "domain": "app.example.com", "status": "verified"}]`)
+	server.setRouteAfter("POST /api/environments/1/domains", "GET /api/environments/1/domains", `[{"id": 62, "environment_id": 1, "domain": "www.example.com", "status": "pending"}]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDomainResourceConfig("app.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/environments/1/domains", `{"domain": "app.example.com"}`),
+					resource.TestCheckResourceAttr("laravelvapor_domain.test", "id", "61"),
+					resource.TestCheckResourceAttr("laravelvapor_domain.test", "environment_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_domain.test", "domain", "app.example.com"),
+					resource.TestCheckResourceAttr("laravelvapor_domain.test", "status", "pending"),
+				),
+			},
+			// Domain change testing
+			{
+				Config: testAccDomainResourceConfig("www.example.com"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_domain.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("DELETE /api/domains/61", ""),
+					server.checkRequest("POST /api/environments/1/domains", `{"domain": "www.example.com"}`),
+					resource.TestCheckResourceAttr("laravelvapor_domain.test", "id", "62"),
+					resource.TestCheckResourceAttr("laravelvapor_domain.test", "domain", "www.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDomainResourceConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_domain" "test" {
+  environment_id = 1
+  domain         = %[1]q
+}
+`, domain)
+}
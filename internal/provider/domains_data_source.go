@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DomainsDataSource{}
+
+func NewDomainsDataSource() datasource.DataSource {
+	return &DomainsDataSource{}
+}
+
+// DomainsDataSource defines the data source implementation.
+type DomainsDataSource struct {
+	client VaporClient
+}
+
+// DomainsDataSourceModel describes the data source data model.
+type DomainsDataSourceModel struct {
+	EnvironmentId types.Int32 `tfsdk:"environment_id"`
+	Domains       types.List  `tfsdk:"domains"`
+}
+
+// DomainModel describes a custom domain object in data source models.
+type DomainModel struct {
+	Id     types.Int32  `tfsdk:"id"`
+	Domain types.String `tfsdk:"domain"`
+	Status types.String `tfsdk:"status"`
+}
+
+var domainAttrTypes = map[string]attr.Type{
+	"id":     types.Int32Type,
+	"domain": types.StringType,
+	"status": types.StringType,
+}
+
+func (d *DomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func (d *DomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "List all custom domains attached to an environment",
+
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID",
+				Required:            true,
+			},
+			"domains": schema.ListNestedAttribute{
+				MarkdownDescription: "Domains list, ordered by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Domain ID",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Domain name",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Domain status",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DomainsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains, err := d.client.GetDomains(ctx, int(data.EnvironmentId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read domains, got error: %s", err))
+		return
+	}
+
+	// Keep a stable order between reads to prevent spurious diffs
+	sort.SliceStable(domains, func(i, j int) bool {
+		return domains[i].Id < domains[j].Id
+	})
+
+	domainModels := []DomainModel{}
+
+	for _, domain := range domains {
+		domainModels = append(domainModels, DomainModel{
+			Id:     types.Int32Value(int32(domain.Id)),
+			Domain: types.StringValue(domain.Domain),
+			Status: types.StringValue(domain.Status),
+		})
+	}
+
+	domainsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: domainAttrTypes}, domainModels)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Domains = domainsValue
+
+	tflog.Trace(ctx, "read domains data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDomainsDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/environments/1/domains": `[{"id": 61, "environment_id": 1, "domain": "app.example.com", "status": "verified"}, {"id": 62, "environment_id": 1, "domain": "www.example.com", "status": "pending"}]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDomainsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_domains.test", "environment_id", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_domains.test", "domains.#", "2"),
+					resource.TestCheckResourceAttr("data.laravelvapor_domains.test", "domains.0.id", "61"),
+					resource.TestCheckResourceAttr("data.laravelvapor_domains.test", "domains.0.domain", "app.example.com"),
+					resource.TestCheckResourceAttr("data.laravelvapor_domains.test", "domains.0.status", "verified"),
+					resource.TestCheckResourceAttr("data.laravelvapor_domains.test", "domains.1.id", "62"),
+					resource.TestCheckResourceAttr("data.laravelvapor_domains.test", "domains.1.domain", "www.example.com"),
+					resource.TestCheckResourceAttr("data.laravelvapor_domains.test", "domains.1.status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDomainsDataSourceConfig = `
+data "laravelvapor_domains" "test" {
+  environment_id = 1
+}
+`
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EnvironmentCacheResource{}
+
+func NewEnvironmentCacheResource() resource.Resource {
+	return &EnvironmentCacheResource{}
+}
+
+// EnvironmentCacheResource defines the resource implementation.
+type EnvironmentCacheResource struct {
+	client VaporClient
+}
+
+// EnvironmentCacheResourceModel describes the resource data model.
+type EnvironmentCacheResourceModel struct {
+	Id            types.Int32 `tfsdk:"id"`
+	EnvironmentId types.Int32 `tfsdk:"environment_id"`
+	CacheId       types.Int32 `tfsdk:"cache_id"`
+}
+
+func (r *EnvironmentCacheResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_cache"
+}
+
+func (r *EnvironmentCacheResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Attach a cache cluster to an environment, the same cache can be attached to many environments. " +
+			"Destroying it only detaches the cache",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Cache ID attached to the environment",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID the cache is attached to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"cache_id": schema.Int32Attribute{
+				MarkdownDescription: "Cache ID to attach",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EnvironmentCacheResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EnvironmentCacheResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentCacheResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AttachEnvironmentCache(ctx, int(data.EnvironmentId.ValueInt32()), int(data.CacheId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("attach cache", err, data.identity()))
+		return
+	}
+
+	data.Id = data.CacheId
+
+	tflog.Trace(ctx, "created an environment cache resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentCacheResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentCacheResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caches, err := r.client.GetEnvironmentCaches(ctx, int(data.EnvironmentId.ValueInt32()))
+
+	// Environment was removed outside of Terraform, along with its attachments
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read environment caches", err, data.identity()))
+		return
+	}
+
+	attached := false
+
+	for _, cache := range caches {
+		if cache.Id == FlexInt(data.CacheId.ValueInt32()) {
+			attached = true
+			break
+		}
+	}
+
+	// Cache was detached outside of Terraform
+	if !attached {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentCacheResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EnvironmentCacheResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes require replacement, nothing to update upstream
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentCacheResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EnvironmentCacheResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DetachEnvironmentCache(ctx, int(data.EnvironmentId.ValueInt32()), int(data.CacheId.ValueInt32()))
+
+	// Already detached outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("detach cache", err, data.identity()))
+		return
+	}
+}
+
+// identity returns the attributes identifying the environment cache in diagnostics.
+func (data *EnvironmentCacheResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"environment_id": data.EnvironmentId,
+		"cache_id":       data.CacheId,
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccEnvironmentCacheResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/environments/1/caches":     ``,
+		"GET /api/environments/1/caches":      `[]`,
+		"DELETE /api/environments/1/caches/1": ``,
+		"DELETE /api/environments/1/caches/2": ``,
+	})
+
+	server.setRouteAfter("POST /api/environments/1/caches", "GET /api/environments/1/caches", `[{"id": 1, "name": "production"}]`)
+	server.setRouteAfter("POST /api/environments/1/caches", "GET /api/environments/1/caches", `[{"id": 2, "name": "staging"}]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentCacheResourceConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/environments/1/caches", `{"cache_id": 1}`),
+					resource.TestCheckResourceAttr("laravelvapor_environment_cache.test", "environment_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_environment_cache.test", "cache_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_environment_cache.test", "id", "1"),
+				),
+			},
+			// Cache change testing
+			{
+				Config: testAccEnvironmentCacheResourceConfig(2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_environment_cache.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("DELETE /api/environments/1/caches/1", ""),
+					server.checkRequest("POST /api/environments/1/caches", `{"cache_id": 2}`),
+					resource.TestCheckResourceAttr("laravelvapor_environment_cache.test", "cache_id", "2"),
+					resource.TestCheckResourceAttr("laravelvapor_environment_cache.test", "id", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEnvironmentCacheResourceConfig(cacheId int) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_environment_cache" "test" {
+  environment_id = 1
+  cache_id       = %[1]d
+}
+`, cacheId)
+}
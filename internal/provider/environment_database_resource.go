@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EnvironmentDatabaseResource{}
+
+func NewEnvironmentDatabaseResource() resource.Resource {
+	return &EnvironmentDatabaseResource{}
+}
+
+// EnvironmentDatabaseResource defines the resource implementation.
+type EnvironmentDatabaseResource struct {
+	client VaporClient
+}
+
+// EnvironmentDatabaseResourceModel describes the resource data model.
+type EnvironmentDatabaseResourceModel struct {
+	Id            types.Int32 `tfsdk:"id"`
+	EnvironmentId types.Int32 `tfsdk:"environment_id"`
+	DatabaseId    types.Int32 `tfsdk:"database_id"`
+}
+
+func (r *EnvironmentDatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_database"
+}
+
+func (r *EnvironmentDatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Attach a RDS database to an environment, the same database can be attached to many environments. " +
+			"Destroying it only detaches the database",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Database ID attached to the environment",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID the database is attached to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"database_id": schema.Int32Attribute{
+				MarkdownDescription: "Database ID to attach",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EnvironmentDatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EnvironmentDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentDatabaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AttachEnvironmentDatabase(ctx, int(data.EnvironmentId.ValueInt32()), int(data.DatabaseId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("attach database", err, data.identity()))
+		return
+	}
+
+	data.Id = data.DatabaseId
+
+	tflog.Trace(ctx, "created an environment database resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentDatabaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databases, err := r.client.GetEnvironmentDatabases(ctx, int(data.EnvironmentId.ValueInt32()))
+
+	// Environment was removed outside of Terraform, along with its attachments
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read environment databases", err, data.identity()))
+		return
+	}
+
+	attached := false
+
+	for _, database := range databases {
+		if database.Id == FlexInt(data.DatabaseId.ValueInt32()) {
+			attached = true
+			break
+		}
+	}
+
+	// Database was detached outside of Terraform
+	if !attached {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EnvironmentDatabaseResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes require replacement, nothing to update upstream
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EnvironmentDatabaseResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DetachEnvironmentDatabase(ctx, int(data.EnvironmentId.ValueInt32()), int(data.DatabaseId.ValueInt32()))
+
+	// Already detached outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("detach database", err, data.identity()))
+		return
+	}
+}
+
+// identity returns the attributes identifying the environment database in diagnostics.
+func (data *EnvironmentDatabaseResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"environment_id": data.EnvironmentId,
+		"database_id":    data.DatabaseId,
+	}
+}
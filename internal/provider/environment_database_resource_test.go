@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccEnvironmentDatabaseResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/environments/1/databases":     ``,
+		"GET /api/environments/1/databases":      `[]`,
+		"DELETE /api/environments/1/databases/1": ``,
+		"DELETE /api/environments/1/databases/2": ``,
+	})
+
+	server.setRouteAfter("POST /api/environments/1/databases", "GET /api/environments/1/databases", `[{"id": 1, "name": "production"}]`)
+	server.setRouteAfter("POST /api/environments/1/databases", "GET /api/environments/1/databases", `[{"id": 2, "name": "staging"}]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentDatabaseResourceConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/environments/1/databases", `{"database_id": 1}`),
+					resource.TestCheckResourceAttr("laravelvapor_environment_database.test", "environment_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_environment_database.test", "database_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_environment_database.test", "id", "1"),
+				),
+			},
+			// Database change testing
+			{
+				Config: testAccEnvironmentDatabaseResourceConfig(2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_environment_database.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("DELETE /api/environments/1/databases/1", ""),
+					server.checkRequest("POST /api/environments/1/databases", `{"database_id": 2}`),
+					resource.TestCheckResourceAttr("laravelvapor_environment_database.test", "database_id", "2"),
+					resource.TestCheckResourceAttr("laravelvapor_environment_database.test", "id", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEnvironmentDatabaseResourceConfig(databaseId int) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_environment_database" "test" {
+  environment_id = 1
+  database_id    = %[1]d
+}
+`, databaseId)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EnvironmentMetricsDataSource{}
+
+func NewEnvironmentMetricsDataSource() datasource.DataSource {
+	return &EnvironmentMetricsDataSource{}
+}
+
+// EnvironmentMetricsDataSource defines the data source implementation.
+type EnvironmentMetricsDataSource struct {
+	client VaporClient
+}
+
+// EnvironmentMetricsDataSourceModel describes the data source data model.
+type EnvironmentMetricsDataSourceModel struct {
+	EnvironmentId   types.Int32   `tfsdk:"environment_id"`
+	Period          types.String  `tfsdk:"period"`
+	Invocations     types.Int64   `tfsdk:"invocations"`
+	Errors          types.Int64   `tfsdk:"errors"`
+	AverageDuration types.Float64 `tfsdk:"average_duration"`
+	MaximumDuration types.Float64 `tfsdk:"maximum_duration"`
+}
+
+func (d *EnvironmentMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_metrics"
+}
+
+func (d *EnvironmentMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Get aggregated function metrics of an environment over a period",
+
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID",
+				Required:            true,
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "Metrics period (e.g. `1h`, `1d`, `7d`)",
+				Required:            true,
+			},
+			"invocations": schema.Int64Attribute{
+				MarkdownDescription: "Total function invocations over the period",
+				Computed:            true,
+			},
+			"errors": schema.Int64Attribute{
+				MarkdownDescription: "Total function errors over the period",
+				Computed:            true,
+			},
+			"average_duration": schema.Float64Attribute{
+				MarkdownDescription: "Average function duration in milliseconds",
+				Computed:            true,
+			},
+			"maximum_duration": schema.Float64Attribute{
+				MarkdownDescription: "Maximum function duration in milliseconds",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EnvironmentMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EnvironmentMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvironmentMetricsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metrics, err := d.client.GetEnvironmentMetrics(ctx, int(data.EnvironmentId.ValueInt32()), data.Period.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read environment metrics, got error: %s", err))
+		return
+	}
+
+	data.Invocations = types.Int64Value(metrics.Invocations)
+	data.Errors = types.Int64Value(metrics.Errors)
+	data.AverageDuration = types.Float64Value(metrics.AverageDuration)
+	data.MaximumDuration = types.Float64Value(metrics.MaximumDuration)
+
+	tflog.Trace(ctx, "read environment metrics data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
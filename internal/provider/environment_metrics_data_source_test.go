@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEnvironmentMetricsDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/environments/1/metrics": `{"invocations": 1200, "errors": 3, "average_duration": 85.5, "maximum_duration": 1020}`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccEnvironmentMetricsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("GET /api/environments/1/metrics", ""),
+					resource.TestCheckResourceAttr("data.laravelvapor_environment_metrics.test", "period", "1d"),
+					resource.TestCheckResourceAttr("data.laravelvapor_environment_metrics.test", "invocations", "1200"),
+					resource.TestCheckResourceAttr("data.laravelvapor_environment_metrics.test", "errors", "3"),
+					resource.TestCheckResourceAttr("data.laravelvapor_environment_metrics.test", "average_duration", "85.5"),
+					resource.TestCheckResourceAttr("data.laravelvapor_environment_metrics.test", "maximum_duration", "1020"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEnvironmentMetricsDataSourceConfig = `
+data "laravelvapor_environment_metrics" "test" {
+  environment_id = 1
+  period         = "1d"
+}
+`
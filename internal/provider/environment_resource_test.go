@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccEnvironmentResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/projects/1/environments":                   `{"id": 11, "project_id": 1, "name": "preview"}`,
+		"GET /api/projects/1/environments":                    `[]`,
+		"DELETE /api/projects/1/environments/preview":         ``,
+		"DELETE /api/projects/1/environments/preview-renamed": ``,
+	})
+
+	server.setRouteAfter("POST /api/projects/1/environments", "POST /api/projects/1/environments", `{"id": 12, "project_id": 1, "name": "preview-renamed"}`)
+	server.setRouteAfter("POST /api/projects/1/environments", "GET /api/projects/1/environments", `[{"id": 11, "project_id": 1, "name": "preview"}]`)
+	server.setRouteAfter("POST /api/projects/1/environments", "GET /api/projects/1/environments", `[{"id": 12, "project_id": 1, "name": "preview-renamed"}]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentResourceConfig("preview"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/projects/1/environments", `{"name": "preview"}`),
+					resource.TestCheckResourceAttr("laravelvapor_environment.test", "id", "11"),
+					resource.TestCheckResourceAttr("laravelvapor_environment.test", "project_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_environment.test", "name", "preview"),
+				),
+			},
+			// Rename testing
+			{
+				Config: testAccEnvironmentResourceConfig("preview-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_environment.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("DELETE /api/projects/1/environments/preview", ""),
+					server.checkRequest("POST /api/projects/1/environments", `{"name": "preview-renamed"}`),
+					resource.TestCheckResourceAttr("laravelvapor_environment.test", "id", "12"),
+					resource.TestCheckResourceAttr("laravelvapor_environment.test", "name", "preview-renamed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEnvironmentResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_environment" "test" {
+  project_id = 1
+  name       = %[1]q
+}
+`, name)
+}
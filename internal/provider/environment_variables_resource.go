@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EnvironmentVariablesResource{}
+
+func NewEnvironmentVariablesResource() resource.Resource {
+	return &EnvironmentVariablesResource{}
+}
+
+// EnvironmentVariablesResource defines the resource implementation.
+type EnvironmentVariablesResource struct {
+	client VaporClient
+}
+
+// EnvironmentVariablesResourceModel describes the resource data model.
+type EnvironmentVariablesResourceModel struct {
+	EnvironmentId types.Int32 `tfsdk:"environment_id"`
+	Variables     types.Map   `tfsdk:"variables"`
+}
+
+func (r *EnvironmentVariablesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variables"
+}
+
+func (r *EnvironmentVariablesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage the whole set of variables of an environment, variables not listed here are removed",
+
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"variables": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Environment variables by name",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *EnvironmentVariablesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EnvironmentVariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentVariablesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var variables map[string]string
+
+	resp.Diagnostics.Append(data.Variables.ElementsAs(ctx, &variables, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateEnvironmentVariables(ctx, int(data.EnvironmentId.ValueInt32()), variables)
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("update environment variables", err, data.identity()))
+		return
+	}
+
+	tflog.Trace(ctx, "created an environment variables resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentVariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentVariablesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, err := r.client.GetEnvironmentVariables(ctx, int(data.EnvironmentId.ValueInt32()))
+
+	// Environment was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read environment variables", err, data.identity()))
+		return
+	}
+
+	// An empty set must not be stored as null, it would differ from an empty map in configuration
+	if variables == nil {
+		variables = map[string]string{}
+	}
+
+	variablesValue, diags := types.MapValueFrom(ctx, types.StringType, variables)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Variables = variablesValue
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentVariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EnvironmentVariablesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var variables map[string]string
+
+	resp.Diagnostics.Append(data.Variables.ElementsAs(ctx, &variables, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API replaces the whole set, the full map is always sent
+	err := r.client.UpdateEnvironmentVariables(ctx, int(data.EnvironmentId.ValueInt32()), variables)
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("update environment variables", err, data.identity()))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentVariablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EnvironmentVariablesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateEnvironmentVariables(ctx, int(data.EnvironmentId.ValueInt32()), nil)
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("remove environment variables", err, data.identity()))
+		return
+	}
+}
+
+// identity returns the attributes identifying the environment variables in diagnostics.
+func (data *EnvironmentVariablesResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"environment_id": data.EnvironmentId,
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEnvironmentVariablesResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"PUT /api/environments/1/variables": `{"variables": {}}`,
+		"GET /api/environments/1/variables": `{"variables": {}}`,
+	})
+
+	server.setRouteAfter("PUT /api/environments/1/variables", "GET /api/environments/1/variables", `{"variables": {"APP_ENV": "staging"}}`)
+	server.setRouteAfter("PUT /api/environments/1/variables", "GET /api/environments/1/variables", `{"variables": {"APP_ENV": "production"}}`)
+	server.setRouteAfter("PUT /api/environments/1/variables", "GET /api/environments/1/variables", `{"variables": {}}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEnvironmentVariablesResourceConfig("staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/environments/1/variables", `{"variables": {"APP_ENV": "staging"}}`),
+					resource.TestCheckResourceAttr("laravelvapor_environment_variables.test", "environment_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_environment_variables.test", "variables.%", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_environment_variables.test", "variables.APP_ENV", "staging"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccEnvironmentVariablesResourceConfig("production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/environments/1/variables", `{"variables": {"APP_ENV": "production"}}`),
+					resource.TestCheckResourceAttr("laravelvapor_environment_variables.test", "variables.APP_ENV", "production"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEnvironmentVariablesResourceConfig(appEnv string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_environment_variables" "test" {
+  environment_id = 1
+  variables = {
+    APP_ENV = %[1]q
+  }
+}
+`, appEnv)
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &JumpboxResource{}
+
+func NewJumpboxResource() resource.Resource {
+	return &JumpboxResource{}
+}
+
+// JumpboxResource defines the resource implementation.
+type JumpboxResource struct {
+	client VaporClient
+}
+
+// JumpboxResourceModel describes the resource data model.
+type JumpboxResourceModel struct {
+	Id           types.Int32    `tfsdk:"id"`
+	NetworkId    types.Int32    `tfsdk:"network_id"`
+	Name         types.String   `tfsdk:"name"`
+	InstanceType types.String   `tfsdk:"instance_type"`
+	Status       types.String   `tfsdk:"status"`
+	PublicIp     types.String   `tfsdk:"public_ip"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *JumpboxResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jumpbox"
+}
+
+func (r *JumpboxResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a jumpbox (bastion host) of a network, creation waits until the jumpbox is ready",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Jumpbox ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.Int32Attribute{
+				MarkdownDescription: "Network ID the jumpbox is created in",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Jumpbox name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_type": schema.StringAttribute{
+				MarkdownDescription: "Jumpbox EC2 instance type (e.g. `t3.nano`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Jumpbox status",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_ip": schema.StringAttribute{
+				MarkdownDescription: "Jumpbox public IP address",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *JumpboxResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *JumpboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data JumpboxResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jumpbox, err := r.client.CreateJumpbox(ctx, int(data.NetworkId.ValueInt32()), data.Name.ValueString(), data.InstanceType.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create jumpbox", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(jumpbox.Id))
+
+	// Nothing was sent in dry run mode, there is nothing to wait for
+	if r.client.dryRun {
+		data.setComputed(jumpbox)
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	_, err = waitForStatus(waitCtx, defaultPollInterval, []string{"creating"}, func() (string, error) {
+		jumpbox, err = r.client.GetJumpbox(waitCtx, int(data.Id.ValueInt32()))
+
+		return jumpbox.Status, err
+	})
+
+	data.setComputed(jumpbox)
+
+	// Jumpbox is kept in state even when provisioning fails, so it is tainted instead of leaked
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("wait for jumpbox creation", err, data.identity()))
+	}
+
+	tflog.Trace(ctx, "created a jumpbox resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JumpboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data JumpboxResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jumpbox, err := r.client.GetJumpbox(ctx, int(data.Id.ValueInt32()))
+
+	// Jumpbox was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read jumpbox", err, data.identity()))
+		return
+	}
+
+	data.setComputed(jumpbox)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JumpboxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data JumpboxResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes require replacement, nothing to update upstream
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JumpboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data JumpboxResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveJumpbox(ctx, int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete jumpbox", err, data.identity()))
+		return
+	}
+}
+
+func (data *JumpboxResourceModel) setComputed(jumpbox *VaporJumpbox) {
+	data.Status = types.StringValue(jumpbox.Status)
+	data.PublicIp = types.StringValue(jumpbox.PublicIp)
+}
+
+// identity returns the attributes identifying the jumpbox in diagnostics.
+func (data *JumpboxResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":         data.Id,
+		"network_id": data.NetworkId,
+		"name":       data.Name,
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJumpboxResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/networks/1/jumpboxes": `{"id": 81, "network_id": 1, "name": "terraform", "instance_type": "t3.nano", "status": "creating"}`,
+		"GET /api/jumpboxes/81":          `{"id": 81, "network_id": 1, "name": "terraform", "instance_type": "t3.nano", "status": "running", "public_ip": "203.0.113.10"}`,
+		"DELETE /api/jumpboxes/81":       ``,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccJumpboxResourceConfig("terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/networks/1/jumpboxes", `{"name": "terraform", "instance_type": "t3.nano"}`),
+					resource.TestCheckResourceAttr("laravelvapor_jumpbox.test", "id", "81"),
+					resource.TestCheckResourceAttr("laravelvapor_jumpbox.test", "network_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_jumpbox.test", "name", "terraform"),
+					resource.TestCheckResourceAttr("laravelvapor_jumpbox.test", "status", "running"),
+					resource.TestCheckResourceAttr("laravelvapor_jumpbox.test", "public_ip", "203.0.113.10"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccJumpboxResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_jumpbox" "test" {
+  network_id    = 1
+  name          = %[1]q
+  instance_type = "t3.nano"
+}
+`, name)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccNetworkResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/teams/79169/networks": `{"id": 71, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "region": "us-east-1", "status": "creating"}`,
+		"GET /api/networks/71":           `{"id": 71, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "region": "us-east-1", "vpc_id": "vpc-0a1b2c3d", "status": "available"}`,
+		"GET /api/networks/72":           `{"id": 72, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "region": "us-east-1", "vpc_id": "vpc-4e5f6a7b", "has_internet_access": true, "status": "available"}`,
+		"DELETE /api/networks/71":        ``,
+		"DELETE /api/networks/72":        ``,
+	})
+
+	server.setRouteAfter("POST /api/teams/79169/networks", "POST /api/teams/79169/networks", `{"id": 72, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "region": "us-east-1", "has_internet_access": true, "status": "creating"}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/teams/79169/networks", `{"cloud_provider_id": 1, "name": "terraform", "region": "us-east-1", "with_internet_access": false}`),
+					resource.TestCheckResourceAttr("laravelvapor_network.test", "id", "71"),
+					resource.TestCheckResourceAttr("laravelvapor_network.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("laravelvapor_network.test", "name", "terraform"),
+					resource.TestCheckResourceAttr("laravelvapor_network.test", "with_nat_gateway", "false"),
+					resource.TestCheckResourceAttr("laravelvapor_network.test", "vpc_id", "vpc-0a1b2c3d"),
+					resource.TestCheckResourceAttr("laravelvapor_network.test", "status", "available"),
+				),
+			},
+			// NAT gateway change testing
+			{
+				Config: testAccNetworkResourceConfig(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_network.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("DELETE /api/networks/71", ""),
+					server.checkRequest("POST /api/teams/79169/networks", `{"cloud_provider_id": 1, "name": "terraform", "region": "us-east-1", "with_internet_access": true}`),
+					resource.TestCheckResourceAttr("laravelvapor_network.test", "id", "72"),
+					resource.TestCheckResourceAttr("laravelvapor_network.test", "with_nat_gateway", "true"),
+					resource.TestCheckResourceAttr("laravelvapor_network.test", "vpc_id", "vpc-4e5f6a7b"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNetworkResourceConfig(withNatGateway bool) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_network" "test" {
+  team_id           = 79169
+  name              = "terraform"
+  region            = "us-east-1"
+  cloud_provider_id = 1
+  with_nat_gateway  = %[1]t
+}
+`, withNatGateway)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"golang.org/x/net/idna"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeZoneFunction{}
+
+// zoneNamePattern matches lowercase ASCII domain names of at least two labels.
+var zoneNamePattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+func NewNormalizeZoneFunction() function.Function {
+	return &NormalizeZoneFunction{}
+}
+
+// NormalizeZoneFunction defines the function implementation.
+type NormalizeZoneFunction struct{}
+
+func (f *NormalizeZoneFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_zone"
+}
+
+func (f *NormalizeZoneFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalize and validate a zone name",
+		MarkdownDescription: "Lowercase a zone domain name and strip its trailing dot, internationalized names are converted to their punycode form. Fails when the name is not a valid domain of at least two labels",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Zone domain name (e.g. `Example.COM.`)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeZoneFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeZoneName(name)
+
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
+
+func normalizeZoneName(name string) (string, error) {
+	normalized := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+
+	ascii, err := idna.Lookup.ToASCII(normalized)
+
+	if err != nil || len(ascii) > 253 || !zoneNamePattern.MatchString(ascii) {
+		return "", fmt.Errorf("invalid zone name: %q", name)
+	}
+
+	return ascii, nil
+}
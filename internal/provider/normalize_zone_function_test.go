@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeZoneFunction(t *testing.T) {
+	tests := map[string]struct {
+		name      string
+		expected  string
+		wantError bool
+	}{
+		"already normalized": {name: "example.com", expected: "example.com"},
+		"uppercase":          {name: "Example.COM", expected: "example.com"},
+		"trailing dot":       {name: "Example.COM.", expected: "example.com"},
+		"subdomain":          {name: "mail.example.co.uk", expected: "mail.example.co.uk"},
+		"idn":                {name: "Bücher.example", expected: "xn--bcher-kva.example"},
+		"punycode":           {name: "xn--bcher-kva.example", expected: "xn--bcher-kva.example"},
+		"single label":       {name: "localhost", wantError: true},
+		"empty":              {name: "", wantError: true},
+		"leading hyphen":     {name: "-example.com", wantError: true},
+		"empty label":        {name: "example..com", wantError: true},
+		"underscore":         {name: "exa_mple.com", wantError: true},
+		"wildcard":           {name: "*.example.com", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(test.name),
+				}),
+			}
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			NewNormalizeZoneFunction().Run(context.Background(), req, &resp)
+
+			if test.wantError {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %s", resp.Result.Value())
+				}
+
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			if !resp.Result.Value().Equal(types.StringValue(test.expected)) {
+				t.Errorf("expected %s, got %s", test.expected, resp.Result.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationResource{}
+
+func NewNotificationResource() resource.Resource {
+	return &NotificationResource{}
+}
+
+// NotificationResource defines the resource implementation.
+type NotificationResource struct {
+	client VaporClient
+}
+
+// NotificationResourceModel describes the resource data model.
+type NotificationResourceModel struct {
+	Id          types.Int32  `tfsdk:"id"`
+	TeamId      types.Int32  `tfsdk:"team_id"`
+	Type        types.String `tfsdk:"type"`
+	Destination types.String `tfsdk:"destination"`
+	Events      types.List   `tfsdk:"events"`
+}
+
+func (r *NotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification"
+}
+
+func (r *NotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a notification channel of a team, sending deployment and alarm events to Slack, Discord, an email or a webhook",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Notification ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID the notification belongs to, defaults to the provider `team_id`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Notification channel, one of `slack`, `discord`, `email` or `webhook`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(notificationTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "Email address for `email` notifications, webhook URL otherwise",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"events": schema.ListAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "Events sent through the channel, any of `deployment.started`, `deployment.succeeded`, `deployment.failed`, " +
+					"`alarm.triggered` or `alarm.resolved`",
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(notificationEvents...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *NotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NotificationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId, diags := teamIdOrDefault(data.TeamId, r.client)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamId = teamId
+
+	notification, diags := data.toNotification(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdNotification, err := r.client.CreateNotification(ctx, int(data.TeamId.ValueInt32()), notification)
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create notification", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(createdNotification.Id))
+
+	tflog.Trace(ctx, "created a notification resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.GetNotification(ctx, int(data.Id.ValueInt32()))
+
+	// Notification was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read notification", err, data.identity()))
+		return
+	}
+
+	data.Type = types.StringValue(notification.Type)
+
+	// Destination is not always returned as it might hold a secret, the configured one is kept then
+	if notification.Destination != "" {
+		data.Destination = types.StringValue(notification.Destination)
+	}
+
+	var events []string
+
+	resp.Diagnostics.Append(data.Events.ElementsAs(ctx, &events, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Events are kept in their configured order when the same ones are returned
+	if notification.Events != nil && !sameNotificationEvents(events, notification.Events) {
+		eventsValue, diags := types.ListValueFrom(ctx, types.StringType, notification.Events)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Events = eventsValue
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NotificationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, diags := data.toNotification(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateNotification(ctx, int(data.Id.ValueInt32()), notification)
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("update notification", err, data.identity()))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NotificationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveNotification(ctx, int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete notification", err, data.identity()))
+		return
+	}
+}
+
+func (data *NotificationResourceModel) toNotification(ctx context.Context) (VaporNotification, diag.Diagnostics) {
+	notification := VaporNotification{
+		TeamId:      FlexInt(data.TeamId.ValueInt32()),
+		Type:        data.Type.ValueString(),
+		Destination: data.Destination.ValueString(),
+		Events:      []string{},
+	}
+
+	diags := data.Events.ElementsAs(ctx, &notification.Events, false)
+
+	return notification, diags
+}
+
+// sameNotificationEvents reports whether both lists hold the same events, regardless of their order.
+func sameNotificationEvents(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortedA := append([]string{}, a...)
+	sortedB := append([]string{}, b...)
+
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// identity returns the attributes identifying the notification in diagnostics.
+func (data *NotificationResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":      data.Id,
+		"team_id": data.TeamId,
+		"type":    data.Type,
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccNotificationResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/teams/79169/notifications": `{"id": 91, "team_id": 79169, "type": "email", "destination": "ops@example.com", "events": ["deployment.failed"]}`,
+		"GET /api/notifications/91":           `{"id": 91, "team_id": 79169, "type": "email", "destination": "ops@example.com", "events": ["deployment.failed"]}`,
+		"PUT /api/notifications/91":           `{"id": 91, "team_id": 79169, "type": "email", "destination": "ops@example.com", "events": ["deployment.failed", "alarm.triggered"]}`,
+		"DELETE /api/notifications/91":        ``,
+	})
+
+	server.setRouteAfter("PUT /api/notifications/91", "GET /api/notifications/91", `{"id": 91, "team_id": 79169, "type": "email", "destination": "ops@example.com", "events": ["alarm.triggered", "deployment.failed"]}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Unknown type testing
+			{
+				Config:      testAccNotificationResourceConfig("teams", `"deployment.failed"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Unknown event testing
+			{
+				Config:      testAccNotificationResourceConfig("email", `"deployment.broken"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccNotificationResourceConfig("email", `"deployment.failed"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/teams/79169/notifications", `{"type": "email", "destination": "ops@example.com", "events": ["deployment.failed"]}`),
+					resource.TestCheckResourceAttr("laravelvapor_notification.test", "id", "91"),
+					resource.TestCheckResourceAttr("laravelvapor_notification.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("laravelvapor_notification.test", "type", "email"),
+					resource.TestCheckResourceAttr("laravelvapor_notification.test", "destination", "ops@example.com"),
+					resource.TestCheckResourceAttr("laravelvapor_notification.test", "events.#", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_notification.test", "events.0", "deployment.failed"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationResourceConfig("email", `"deployment.failed", "alarm.triggered"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_notification.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/notifications/91", `{"destination": "ops@example.com", "events": ["deployment.failed", "alarm.triggered"]}`),
+					resource.TestCheckResourceAttr("laravelvapor_notification.test", "events.#", "2"),
+					resource.TestCheckResourceAttr("laravelvapor_notification.test", "events.0", "deployment.failed"),
+					resource.TestCheckResourceAttr("laravelvapor_notification.test", "events.1", "alarm.triggered"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationResourceConfig(notificationType string, events string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_notification" "test" {
+  team_id     = 79169
+  type        = %[1]q
+  destination = "ops@example.com"
+  events      = [%[2]s]
+}
+`, notificationType, events)
+}
+
+func TestSameNotificationEvents(t *testing.T) {
+	tests := map[string]struct {
+		a, b []string
+		want bool
+	}{
+		"same order":      {a: []string{"deployment.failed", "alarm.triggered"}, b: []string{"deployment.failed", "alarm.triggered"}, want: true},
+		"different order": {a: []string{"deployment.failed", "alarm.triggered"}, b: []string{"alarm.triggered", "deployment.failed"}, want: true},
+		"missing":         {a: []string{"deployment.failed", "alarm.triggered"}, b: []string{"deployment.failed"}},
+		"different":       {a: []string{"deployment.failed"}, b: []string{"deployment.started"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := sameNotificationEvents(test.a, test.b); got != test.want {
+				t.Errorf("expected %t, got %t", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccProjectResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/teams/79169/projects": `{"id": 21, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "region": "us-east-1"}`,
+		"GET /api/projects/21":           `{"id": 21, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform", "region": "us-east-1"}`,
+		"PUT /api/projects/21":           `{"id": 21, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform-renamed", "region": "us-east-1"}`,
+		"DELETE /api/projects/21":        ``,
+		"GET /api/projects/22":           `{"id": 22, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform-renamed", "region": "eu-west-1"}`,
+		"DELETE /api/projects/22":        ``,
+	})
+
+	server.setRouteAfter("PUT /api/projects/21", "GET /api/projects/21", `{"id": 21, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform-renamed", "region": "us-east-1"}`)
+	server.setRouteAfter("POST /api/teams/79169/projects", "POST /api/teams/79169/projects", `{"id": 22, "team_id": 79169, "cloud_provider_id": 1, "name": "terraform-renamed", "region": "eu-west-1"}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectResourceConfig("terraform", "us-east-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/teams/79169/projects", `{"cloud_provider_id": 1, "name": "terraform", "region": "us-east-1"}`),
+					resource.TestCheckResourceAttr("laravelvapor_project.test", "id", "21"),
+					resource.TestCheckResourceAttr("laravelvapor_project.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("laravelvapor_project.test", "name", "terraform"),
+					resource.TestCheckResourceAttr("laravelvapor_project.test", "region", "us-east-1"),
+				),
+			},
+			// Rename testing
+			{
+				Config: testAccProjectResourceConfig("terraform-renamed", "us-east-1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_project.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/projects/21", `{"name": "terraform-renamed"}`),
+					resource.TestCheckResourceAttr("laravelvapor_project.test", "id", "21"),
+					resource.TestCheckResourceAttr("laravelvapor_project.test", "name", "terraform-renamed"),
+				),
+			},
+			// Region change testing
+			{
+				Config: testAccProjectResourceConfig("terraform-renamed", "eu-west-1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_project.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("DELETE /api/projects/21", ""),
+					server.checkRequest("POST /api/teams/79169/projects", `{"cloud_provider_id": 1, "name": "terraform-renamed", "region": "eu-west-1"}`),
+					resource.TestCheckResourceAttr("laravelvapor_project.test", "id", "22"),
+					resource.TestCheckResourceAttr("laravelvapor_project.test", "region", "eu-west-1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceConfig(name string, region string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_project" "test" {
+  team_id           = 79169
+  name              = %[1]q
+  region            = %[2]q
+  cloud_provider_id = 1
+}
+`, name, region)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectsDataSource{}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+// ProjectsDataSource defines the data source implementation.
+type ProjectsDataSource struct {
+	client VaporClient
+}
+
+// ProjectsDataSourceModel describes the data source data model.
+type ProjectsDataSourceModel struct {
+	TeamId   types.Int32 `tfsdk:"team_id"`
+	Projects types.List  `tfsdk:"projects"`
+}
+
+// ProjectModel describes a project object in data source models.
+type ProjectModel struct {
+	Id               types.Int32  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Region           types.String `tfsdk:"region"`
+	GithubRepository types.String `tfsdk:"github_repository"`
+}
+
+var projectAttrTypes = map[string]attr.Type{
+	"id":                types.Int32Type,
+	"name":              types.StringType,
+	"region":            types.StringType,
+	"github_repository": types.StringType,
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "List all projects of a team",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID",
+				Required:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "Projects list, ordered by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Project ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Project name",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Project AWS region",
+							Computed:            true,
+						},
+						"github_repository": schema.StringAttribute{
+							MarkdownDescription: "Project GitHub repository",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := d.client.GetProjects(ctx, int(data.TeamId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read projects, got error: %s", err))
+		return
+	}
+
+	// Keep a stable order between reads to prevent spurious diffs
+	sort.SliceStable(projects, func(i, j int) bool {
+		return projects[i].Id < projects[j].Id
+	})
+
+	projectModels := []ProjectModel{}
+
+	for _, project := range projects {
+		projectModels = append(projectModels, ProjectModel{
+			Id:               types.Int32Value(int32(project.Id)),
+			Name:             types.StringValue(project.Name),
+			Region:           types.StringValue(project.Region),
+			GithubRepository: types.StringValue(project.GithubRepository),
+		})
+	}
+
+	projectsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: projectAttrTypes}, projectModels)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Projects = projectsValue
+
+	tflog.Trace(ctx, "read projects data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectsDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/teams/79169/projects": `[{"id": 21, "team_id": 79169, "name": "terraform", "region": "us-east-1", "github_repository": "open-southeners/terraform"}, {"id": 22, "team_id": 79169, "name": "website", "region": "eu-west-1"}]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProjectsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_projects.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("data.laravelvapor_projects.test", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.laravelvapor_projects.test", "projects.0.id", "21"),
+					resource.TestCheckResourceAttr("data.laravelvapor_projects.test", "projects.0.name", "terraform"),
+					resource.TestCheckResourceAttr("data.laravelvapor_projects.test", "projects.0.region", "us-east-1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_projects.test", "projects.0.github_repository", "open-southeners/terraform"),
+					resource.TestCheckResourceAttr("data.laravelvapor_projects.test", "projects.1.id", "22"),
+					resource.TestCheckResourceAttr("data.laravelvapor_projects.test", "projects.1.name", "website"),
+					resource.TestCheckResourceAttr("data.laravelvapor_projects.test", "projects.1.github_repository", ""),
+				),
+			},
+		},
+	})
+}
+
+const testAccProjectsDataSourceConfig = `
+data "laravelvapor_projects" "test" {
+  team_id = 79169
+}
+`
@@ -5,15 +5,26 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
 	"net/http"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -28,12 +39,21 @@ type LaravelVaporProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// client is the configured API client, nil until Configure has run
+	client *VaporClient
 }
 
 // LaravelVaporProviderModel describes the provider data model.
 type LaravelVaporProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Host               types.String `tfsdk:"host"`
+	Token              types.String `tfsdk:"token"`
+	TokenFile          types.String `tfsdk:"token_file"`
+	RequestTimeout     types.Int64  `tfsdk:"request_timeout"`
+	TeamId             types.Int32  `tfsdk:"team_id"`
+	DryRun             types.Bool   `tfsdk:"dry_run"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ApiBasePath        types.String `tfsdk:"api_base_path"`
 }
 
 func (p *LaravelVaporProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -45,11 +65,39 @@ func (p *LaravelVaporProvider) Schema(ctx context.Context, req provider.SchemaRe
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				MarkdownDescription: "A host for Laravel Vapor (use mainly for tests or dry run)",
+				MarkdownDescription: "A host for Laravel Vapor (use mainly for tests or dry run), can also be set with the `LARAVEL_VAPOR_HOST` environment variable",
 				Optional:            true,
 			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "A valid API token for Laravel Vapor",
+				MarkdownDescription: "A valid API token for Laravel Vapor, can also be set with the `LARAVEL_VAPOR_TOKEN` environment variable",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the API token, used when `token` is not set, can also be set with the `LARAVEL_VAPOR_TOKEN_FILE` environment variable. Takes precedence over the `LARAVEL_VAPOR_TOKEN` environment variable",
+				Optional:            true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds for each request to Laravel Vapor API (defaults to 30)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Default team ID used by resources without their own `team_id`, can also be set with the `LARAVEL_VAPOR_TEAM_ID` environment variable",
+				Optional:            true,
+			},
+			"dry_run": schema.BoolAttribute{
+				MarkdownDescription: "Log the requests changing Laravel Vapor resources instead of sending them, reads are still sent. Applies succeed without changing anything, created resources are saved with placeholder values without waiting for their status",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the TLS certificate verification of the host, only meant for mocks served with a self-signed certificate (defaults to false)",
+				Optional:            true,
+			},
+			"api_base_path": schema.StringAttribute{
+				MarkdownDescription: "Path prepended to every Laravel Vapor API request path after the host, for an API mounted under a subpath by a proxy (e.g. `/vapor`), empty by default",
 				Optional:            true,
 			},
 		},
@@ -65,41 +113,256 @@ func (p *LaravelVaporProvider) Configure(ctx context.Context, req provider.Confi
 		return
 	}
 
+	// Values only known after apply cannot fall back to the environment
+	if data.Token.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Unknown Laravel Vapor API Token",
+			"The provider cannot create the Laravel Vapor API client as there is an unknown configuration value for the API token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the LARAVEL_VAPOR_TOKEN environment variable.",
+		)
+
+		return
+	}
+
+	var host string
+
+	if !data.Host.IsNull() {
+		host = data.Host.ValueString()
+	} else if v := os.Getenv("LARAVEL_VAPOR_HOST"); v != "" {
+		host = v
+	}
+
+	var tokenFile string
+
+	if !data.TokenFile.IsNull() {
+		tokenFile = data.TokenFile.ValueString()
+	} else if v := os.Getenv("LARAVEL_VAPOR_TOKEN_FILE"); v != "" {
+		tokenFile = v
+	}
+
 	var token string
 	// Configuration values are now available.
 	if !data.Token.IsNull() {
 		token = data.Token.ValueString()
+	} else if tokenFile != "" {
+		contents, err := os.ReadFile(tokenFile)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_file"),
+				"Unreadable Laravel Vapor API Token File",
+				fmt.Sprintf("The provider cannot read the API token from %q, got error: %s", tokenFile, err),
+			)
+
+			return
+		}
+
+		token = strings.TrimSpace(string(contents))
+
+		if token == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_file"),
+				"Empty Laravel Vapor API Token File",
+				fmt.Sprintf("The API token file %q is empty.", tokenFile),
+			)
+
+			return
+		}
 	} else if v := os.Getenv("LARAVEL_VAPOR_TOKEN"); v != "" {
 		token = v
 	}
 
+	// Custom hosts are mainly mocks used for tests or dry runs, no real token is needed there
+	validateToken := host == "" || host == defaultApiHost
+
+	if validateToken && token == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Missing Laravel Vapor API Token",
+			"The provider cannot create the Laravel Vapor API client as there is no API token. "+
+				"Set the `token` or `token_file` attribute in the provider configuration, or the LARAVEL_VAPOR_TOKEN environment variable.",
+		)
+
+		return
+	}
+
+	var teamId int
+
+	if !data.TeamId.IsNull() {
+		teamId = int(data.TeamId.ValueInt32())
+	} else if v := os.Getenv("LARAVEL_VAPOR_TEAM_ID"); v != "" {
+		var err error
+
+		teamId, err = strconv.Atoi(v)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("team_id"),
+				"Invalid Team ID",
+				fmt.Sprintf("The LARAVEL_VAPOR_TEAM_ID environment variable must be a number, got: %q", v),
+			)
+
+			return
+		}
+	}
+
+	timeout := defaultRequestTimeout
+
+	if !data.RequestTimeout.IsNull() {
+		timeout = time.Duration(data.RequestTimeout.ValueInt64()) * time.Second
+	}
+
 	// Example client configuration for data sources and resources
 	client := VaporClient{
-		apiToken: token,
-		Http:     *http.DefaultClient,
+		apiToken:       token,
+		apiHost:        host,
+		apiBasePath:    data.ApiBasePath.ValueString(),
+		defaultTeamId:  teamId,
+		dryRun:         data.DryRun.ValueBool(),
+		MaxRetries:     defaultMaxRetries,
+		RetryBaseDelay: defaultRetryBaseDelay,
+		Http:           *http.DefaultClient,
+	}
+	client.Http.Timeout = timeout
+
+	if data.InsecureSkipVerify.ValueBool() {
+		transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+
+		// Keep the default transport settings when it has not been replaced
+		if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+			transport = defaultTransport.Clone()
+		}
+
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+		client.Http.Transport = transport
+
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"The provider does not verify the TLS certificate of the Laravel Vapor API host. "+
+				"Only use it against local mocks, never against the real Laravel Vapor API.",
+		)
+	}
+
+	if validateToken {
+		_, err := client.Ping(ctx)
+
+		if isUnauthorized(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token"),
+				"Invalid Laravel Vapor API token",
+				"The Laravel Vapor API rejected the configured token. Check that the token exists and has not been revoked.",
+			)
+
+			return
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to validate Laravel Vapor API token, got error: %s", err))
+			return
+		}
 	}
+
+	p.client = &client
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *LaravelVaporProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewExampleResource,
+		NewTeamResource,
+		NewTeamMemberResource,
+		NewCloudProviderResource,
+		NewZoneResource,
+		NewZoneRecordResource,
+		NewZoneRecordsResource,
+		NewProjectResource,
+		NewEnvironmentResource,
+		NewEnvironmentVariablesResource,
+		NewEnvironmentDatabaseResource,
+		NewEnvironmentCacheResource,
+		NewSecretResource,
+		NewDatabaseResource,
+		NewDatabaseUserResource,
+		NewCacheResource,
+		NewCertificateResource,
+		NewDomainResource,
+		NewJumpboxResource,
+		NewNetworkResource,
+		NewDeploymentResource,
+		NewRollbackResource,
+		NewCommandResource,
+		NewAlarmResource,
+		NewNotificationResource,
 	}
 }
 
 func (p *LaravelVaporProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewDeploymentTokenEphemeralResource,
+		NewAccountEphemeralResource,
+	}
 }
 
 func (p *LaravelVaporProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAccountDataSource,
+		NewTeamsDataSource,
+		NewTeamDataSource,
+		NewTeamMembersDataSource,
+		NewTeamPermissionsDataSource,
+		NewCloudProviderDataSource,
+		NewZonesDataSource,
+		NewZoneDataSource,
+		NewZoneRecordsDataSource,
+		NewProjectsDataSource,
+		NewDeploymentsDataSource,
+		NewDeploymentLogDataSource,
+		NewDomainsDataSource,
+		NewBalancersDataSource,
+		NewDatabasesDataSource,
+		NewDatabaseDataSource,
+		NewEnvironmentMetricsDataSource,
+		NewQueueMetricsDataSource,
+		NewZoneSesStatusDataSource,
+		NewStatusDataSource,
 	}
 }
 
 func (p *LaravelVaporProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewBuildRoleArnFunction,
+		NewNormalizeZoneFunction,
+		func() function.Function {
+			return NewTeamIdFunction(p)
+		},
+	}
+}
+
+// teamIdOrDefault returns the configured team ID, or the provider default team ID when unset.
+func teamIdOrDefault(teamId types.Int32, client VaporClient) (types.Int32, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !teamId.IsNull() && !teamId.IsUnknown() {
+		return teamId, diags
+	}
+
+	if client.defaultTeamId == 0 {
+		diags.AddAttributeError(
+			path.Root("team_id"),
+			"Missing Team ID",
+			"Set the `team_id` attribute on the resource, or a default `team_id` in the provider configuration.",
+		)
+
+		return teamId, diags
+	}
+
+	return types.Int32Value(int32(client.defaultTeamId)), diags
 }
 
 func New(version string) func() provider.Provider {
@@ -109,3 +372,34 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// newClientError returns the diagnostic of a failed API call, detailed with the attributes identifying
+// the resource involved so failures can be told apart when many resources are applied at once.
+func newClientError(action string, err error, identity map[string]attr.Value) diag.Diagnostic {
+	detail := fmt.Sprintf("Unable to %s, got error: %s", action, err)
+
+	names := make([]string, 0, len(identity))
+
+	for name := range identity {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	attributes := []string{}
+
+	for _, name := range names {
+		// Values not known yet, e.g. IDs before creation, tell nothing
+		if identity[name].IsNull() || identity[name].IsUnknown() {
+			continue
+		}
+
+		attributes = append(attributes, name+" = "+identity[name].String())
+	}
+
+	if len(attributes) > 0 {
+		detail += "\n\nResource attributes: " + strings.Join(attributes, ", ")
+	}
+
+	return diag.NewErrorDiagnostic("Client Error", detail)
+}
@@ -4,11 +4,31 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
@@ -27,9 +47,474 @@ var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.Provi
 	"echo":         echoprovider.NewProviderServer(),
 }
 
+// testRoutes maps a request method and path, e.g. "GET /api/user", to the JSON body the API mock responds with.
+type testRoutes map[string]string
+
+// testServer is an API mock started by newTestServer, recording the requests it receives.
+type testServer struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	routes   testRoutes
+	removed  map[string]bool
+	changes  []testRouteChange
+	requests []testRequest
+}
+
+// testRouteChange is a new body a route responds with once its trigger route is requested, the route responds with a 404 once removed.
+type testRouteChange struct {
+	Trigger string
+	Route   string
+	Body    string
+	Removed bool
+}
+
+// testRequest is a request received by a testServer, identified by its route, e.g. "POST /api/teams".
+type testRequest struct {
+	Route string
+	Body  string
+}
+
+// newTestServer starts an API mock responding with canned JSON bodies, unknown routes fail the test with a 404 response.
+// The server is closed when the test finishes.
+func newTestServer(t *testing.T, routes testRoutes) *testServer {
+	t.Helper()
+
+	server := &testServer{routes: routes, removed: map[string]bool{}}
+
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		requestBody, _ := io.ReadAll(r.Body)
+
+		server.mutex.Lock()
+		server.requests = append(server.requests, testRequest{Route: route, Body: string(requestBody)})
+		body, ok := server.routes[route]
+		removed := server.removed[route]
+
+		// Each trigger request applies the next change waiting on it for every route
+		changed := map[string]bool{}
+		waiting := []testRouteChange{}
+
+		for _, change := range server.changes {
+			if change.Trigger != route || changed[change.Route] {
+				waiting = append(waiting, change)
+				continue
+			}
+
+			server.routes[change.Route] = change.Body
+			server.removed[change.Route] = change.Removed
+			changed[change.Route] = true
+		}
+
+		server.changes = waiting
+
+		server.mutex.Unlock()
+
+		if removed {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Not Found."}`))
+
+			return
+		}
+
+		if !ok {
+			t.Errorf("unexpected request %s", route)
+
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Not Found."}`))
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// setRouteAfter changes the body a route responds with once the trigger route is requested, e.g. to mock the changes applied by an update.
+// Changes of a route waiting on the same trigger are applied one per request, in the order they were added.
+func (s *testServer) setRouteAfter(trigger string, route string, body string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.changes = append(s.changes, testRouteChange{Trigger: trigger, Route: route, Body: body})
+}
+
+// removeRouteAfter makes a route respond with a 404 once the trigger route is requested, e.g. to mock a deletion.
+func (s *testServer) removeRouteAfter(trigger string, route string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.changes = append(s.changes, testRouteChange{Trigger: trigger, Route: route, Removed: true})
+}
+
+// checkRequest returns a check asserting the route was requested, with the given JSON body on its last request unless empty.
+func (s *testServer) checkRequest(route string, body string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		for i := len(s.requests) - 1; i >= 0; i-- {
+			if s.requests[i].Route != route {
+				continue
+			}
+
+			if body == "" {
+				return nil
+			}
+
+			var got, expected interface{}
+
+			if err := json.Unmarshal([]byte(s.requests[i].Body), &got); err != nil {
+				return fmt.Errorf("%s request body is not JSON: %q", route, s.requests[i].Body)
+			}
+
+			if err := json.Unmarshal([]byte(body), &expected); err != nil {
+				return fmt.Errorf("expected %s request body is not JSON: %w", route, err)
+			}
+
+			if !reflect.DeepEqual(got, expected) {
+				return fmt.Errorf("expected %s request body %s, got %s", route, body, s.requests[i].Body)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("expected a %s request, none was sent", route)
+	}
+}
+
+// checkRequestCount returns a check asserting how many times the route was requested so far.
+func (s *testServer) checkRequestCount(route string, count int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		sent := 0
+
+		for _, request := range s.requests {
+			if request.Route == route {
+				sent++
+			}
+		}
+
+		if sent != count {
+			return fmt.Errorf("expected %d %s requests, got %d", count, route, sent)
+		}
+
+		return nil
+	}
+}
+
+// testFixture returns a canned API response body from the http-tests/api folder.
+func testFixture(t *testing.T, name string) string {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("..", "..", "http-tests", "api", name))
+
+	if err != nil {
+		t.Fatalf("unable to read fixture %s: %s", name, err)
+	}
+
+	return string(body)
+}
+
+// testAccProtoV6ProviderFactoriesWithHost returns provider factories sending requests to the given host, usually a newTestServer URL.
+// Acceptance tests run the provider in process, so it is configured through the environment variables it reads.
+func testAccProtoV6ProviderFactoriesWithHost(t *testing.T, host string) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Setenv("LARAVEL_VAPOR_HOST", host)
+	t.Setenv("LARAVEL_VAPOR_TOKEN", "test-token")
+
+	return testAccProtoV6ProviderFactories
+}
+
 func testAccPreCheck(t *testing.T) string {
 	return `provider "laravelvapor" {
 		host  = "localhost:8080"
 		token = ""
 	}`
 }
+
+func TestNewTestServer(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/user": testFixture(t, "user.json"),
+	})
+
+	client := VaporClient{apiHost: server.URL}
+
+	account, err := client.GetAccount(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if account.Id != 19870 || len(account.OwnedTeams) != 2 {
+		t.Errorf("expected the canned account, got %+v", account)
+	}
+}
+
+func TestTeamIdOrDefault(t *testing.T) {
+	client := VaporClient{defaultTeamId: 42}
+
+	teamId, diags := teamIdOrDefault(types.Int32Value(7), client)
+
+	if diags.HasError() || teamId.ValueInt32() != 7 {
+		t.Errorf("expected the configured team ID to win, got %v", teamId)
+	}
+
+	teamId, diags = teamIdOrDefault(types.Int32Unknown(), client)
+
+	if diags.HasError() || teamId.ValueInt32() != 42 {
+		t.Errorf("expected the provider default team ID, got %v", teamId)
+	}
+
+	_, diags = teamIdOrDefault(types.Int32Null(), VaporClient{})
+
+	if !diags.HasError() {
+		t.Error("expected an error without any team ID")
+	}
+}
+
+func TestProviderConfigureHost(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	// The configured host wins over the environment one
+	t.Setenv("LARAVEL_VAPOR_HOST", "http://127.0.0.1:1")
+
+	p := &LaravelVaporProvider{}
+
+	resp := configureProvider(t, p, LaravelVaporProviderModel{
+		Host:               types.StringValue(server.URL),
+		Token:              types.StringNull(),
+		TokenFile:          types.StringNull(),
+		RequestTimeout:     types.Int64Null(),
+		TeamId:             types.Int32Null(),
+		DryRun:             types.BoolNull(),
+		InsecureSkipVerify: types.BoolNull(),
+		ApiBasePath:        types.StringNull(),
+	})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	client, ok := resp.ResourceData.(VaporClient)
+
+	if !ok {
+		t.Fatalf("expected VaporClient resource data, got: %T", resp.ResourceData)
+	}
+
+	if _, err := client.GetTeams(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected the request to reach the configured host, got %d requests", requests)
+	}
+}
+
+func TestProviderConfigureInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	for _, insecure := range []bool{false, true} {
+		p := &LaravelVaporProvider{}
+
+		resp := configureProvider(t, p, LaravelVaporProviderModel{
+			Host:               types.StringValue(server.URL),
+			Token:              types.StringNull(),
+			TokenFile:          types.StringNull(),
+			RequestTimeout:     types.Int64Null(),
+			TeamId:             types.Int32Null(),
+			DryRun:             types.BoolNull(),
+			InsecureSkipVerify: types.BoolValue(insecure),
+			ApiBasePath:        types.StringNull(),
+		})
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		if (resp.Diagnostics.WarningsCount() == 1) != insecure {
+			t.Errorf("expected a warning only when TLS verification is skipped, got: %v", resp.Diagnostics)
+		}
+
+		// The test server certificate is self-signed
+		_, err := p.client.GetTeams(context.Background())
+
+		if insecure && err != nil {
+			t.Errorf("expected the self-signed certificate to be accepted, got error: %s", err)
+		}
+
+		if !insecure && err == nil {
+			t.Error("expected the self-signed certificate to be rejected")
+		}
+	}
+}
+
+func TestProviderConfigureUnknownToken(t *testing.T) {
+	t.Setenv("LARAVEL_VAPOR_TOKEN", "env-token")
+
+	p := &LaravelVaporProvider{}
+
+	resp := configureProvider(t, p, LaravelVaporProviderModel{
+		Host:               types.StringValue("http://localhost:8080"),
+		Token:              types.StringUnknown(),
+		TokenFile:          types.StringNull(),
+		RequestTimeout:     types.Int64Null(),
+		TeamId:             types.Int32Null(),
+		DryRun:             types.BoolNull(),
+		InsecureSkipVerify: types.BoolNull(),
+		ApiBasePath:        types.StringNull(),
+	})
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Unknown Laravel Vapor API Token" {
+		t.Errorf("expected an unknown token error instead of falling back to the environment, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestProviderConfigureTokenFile(t *testing.T) {
+	dir := t.TempDir()
+
+	tokenFile := filepath.Join(dir, "token")
+	emptyFile := filepath.Join(dir, "empty")
+
+	if err := os.WriteFile(tokenFile, []byte("file-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(emptyFile, []byte(" \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		token     types.String
+		tokenFile types.String
+		env       map[string]string
+		expected  string
+		wantError bool
+	}{
+		"file":          {token: types.StringNull(), tokenFile: types.StringValue(tokenFile), expected: "file-token"},
+		"token wins":    {token: types.StringValue("token"), tokenFile: types.StringValue(tokenFile), expected: "token"},
+		"file wins env": {token: types.StringNull(), tokenFile: types.StringValue(tokenFile), env: map[string]string{"LARAVEL_VAPOR_TOKEN": "env-token"}, expected: "file-token"},
+		"file from env": {token: types.StringNull(), tokenFile: types.StringNull(), env: map[string]string{"LARAVEL_VAPOR_TOKEN_FILE": tokenFile}, expected: "file-token"},
+		"env token":     {token: types.StringNull(), tokenFile: types.StringNull(), env: map[string]string{"LARAVEL_VAPOR_TOKEN": "env-token"}, expected: "env-token"},
+		"empty file":    {token: types.StringNull(), tokenFile: types.StringValue(emptyFile), wantError: true},
+		"missing file":  {token: types.StringNull(), tokenFile: types.StringValue(filepath.Join(dir, "missing")), wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("LARAVEL_VAPOR_TOKEN", "")
+			t.Setenv("LARAVEL_VAPOR_TOKEN_FILE", "")
+
+			for key, value := range test.env {
+				t.Setenv(key, value)
+			}
+
+			p := &LaravelVaporProvider{}
+
+			// Custom hosts skip the token validation request
+			resp := configureProvider(t, p, LaravelVaporProviderModel{
+				Host:               types.StringValue("http://localhost:8080"),
+				Token:              test.token,
+				TokenFile:          test.tokenFile,
+				RequestTimeout:     types.Int64Null(),
+				TeamId:             types.Int32Null(),
+				DryRun:             types.BoolNull(),
+				InsecureSkipVerify: types.BoolNull(),
+				ApiBasePath:        types.StringNull(),
+			})
+
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Fatalf("expected error to be %t, got diagnostics: %v", test.wantError, resp.Diagnostics)
+			}
+
+			if !test.wantError && p.client.apiToken != test.expected {
+				t.Errorf("expected token %q, got %q", test.expected, p.client.apiToken)
+			}
+		})
+	}
+}
+
+func TestProviderRequestTimeoutValidation(t *testing.T) {
+	schemaResp := provider.SchemaResponse{}
+
+	New("test")().Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
+
+	validators := schemaResp.Schema.Attributes["request_timeout"].(schema.Int64Attribute).Validators
+
+	tests := map[string]struct {
+		timeout   types.Int64
+		wantError bool
+	}{
+		"default":  {timeout: types.Int64Null()},
+		"seconds":  {timeout: types.Int64Value(1)},
+		"zero":     {timeout: types.Int64Value(0), wantError: true},
+		"negative": {timeout: types.Int64Value(-30), wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := validator.Int64Response{}
+
+			for _, v := range validators {
+				v.ValidateInt64(context.Background(), validator.Int64Request{ConfigValue: test.timeout}, &resp)
+			}
+
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Errorf("expected validation error to be %t, got diagnostics: %v", test.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestNewClientError(t *testing.T) {
+	diagnostic := newClientError("create zone", errors.New("422 POST request failed"), map[string]attr.Value{
+		"zone":    types.StringValue("example.com"),
+		"team_id": types.Int32Value(79169),
+		"id":      types.Int32Unknown(),
+	})
+
+	expected := "Unable to create zone, got error: 422 POST request failed\n\nResource attributes: team_id = 79169, zone = \"example.com\""
+
+	if diagnostic.Summary() != "Client Error" || diagnostic.Detail() != expected {
+		t.Errorf("unexpected diagnostic %q: %q", diagnostic.Summary(), diagnostic.Detail())
+	}
+}
+
+func configureProvider(t *testing.T, p *LaravelVaporProvider, data LaravelVaporProviderModel) provider.ConfigureResponse {
+	ctx := context.Background()
+	schemaResp := provider.SchemaResponse{}
+
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	// Plans share the config representation and can be built from the model
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	if diags := plan.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := provider.ConfigureResponse{}
+
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}}, &resp)
+
+	return resp
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QueueMetricsDataSource{}
+
+func NewQueueMetricsDataSource() datasource.DataSource {
+	return &QueueMetricsDataSource{}
+}
+
+// QueueMetricsDataSource defines the data source implementation.
+type QueueMetricsDataSource struct {
+	client VaporClient
+}
+
+// QueueMetricsDataSourceModel describes the data source data model.
+type QueueMetricsDataSourceModel struct {
+	EnvironmentId types.Int32  `tfsdk:"environment_id"`
+	Period        types.String `tfsdk:"period"`
+	HasData       types.Bool   `tfsdk:"has_data"`
+	Processed     types.Int64  `tfsdk:"processed"`
+	Failed        types.Int64  `tfsdk:"failed"`
+	Pending       types.Int64  `tfsdk:"pending"`
+}
+
+func (d *QueueMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue_metrics"
+}
+
+func (d *QueueMetricsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Get queued job counts of an environment over a period. " +
+			"Counts are zero when the environment has no queue configured yet, `has_data` tells it apart from an idle queue",
+
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID",
+				Required:            true,
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "Metrics period (e.g. `1h`, `1d`, `7d`)",
+				Required:            true,
+			},
+			"has_data": schema.BoolAttribute{
+				MarkdownDescription: "Did the environment report queue metrics, false while it has no queue configured",
+				Computed:            true,
+			},
+			"processed": schema.Int64Attribute{
+				MarkdownDescription: "Jobs processed over the period",
+				Computed:            true,
+			},
+			"failed": schema.Int64Attribute{
+				MarkdownDescription: "Jobs failed over the period",
+				Computed:            true,
+			},
+			"pending": schema.Int64Attribute{
+				MarkdownDescription: "Jobs currently waiting in the queue",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *QueueMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *QueueMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QueueMetricsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metrics, err := d.client.GetQueueMetrics(ctx, int(data.EnvironmentId.ValueInt32()), data.Period.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read queue metrics, got error: %s", err))
+		return
+	}
+
+	data.setMetrics(metrics)
+
+	tflog.Trace(ctx, "read queue metrics data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setMetrics fills the counts, missing ones are zero so they can be used without null checks.
+func (data *QueueMetricsDataSourceModel) setMetrics(metrics *VaporQueueMetrics) {
+	count := func(value *int64) types.Int64 {
+		if value == nil {
+			return types.Int64Value(0)
+		}
+
+		return types.Int64Value(*value)
+	}
+
+	data.HasData = types.BoolValue(metrics.Processed != nil || metrics.Failed != nil || metrics.Pending != nil)
+	data.Processed = count(metrics.Processed)
+	data.Failed = count(metrics.Failed)
+	data.Pending = count(metrics.Pending)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueueMetricsDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/environments/1/queue-metrics": `{"processed": 120, "failed": 3, "pending": 0}`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccQueueMetricsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("GET /api/environments/1/queue-metrics", ""),
+					resource.TestCheckResourceAttr("data.laravelvapor_queue_metrics.test", "period", "1d"),
+					resource.TestCheckResourceAttr("data.laravelvapor_queue_metrics.test", "has_data", "true"),
+					resource.TestCheckResourceAttr("data.laravelvapor_queue_metrics.test", "processed", "120"),
+					resource.TestCheckResourceAttr("data.laravelvapor_queue_metrics.test", "failed", "3"),
+					resource.TestCheckResourceAttr("data.laravelvapor_queue_metrics.test", "pending", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccQueueMetricsDataSourceConfig = `
+data "laravelvapor_queue_metrics" "test" {
+  environment_id = 1
+  period         = "1d"
+}
+`
+
+func TestQueueMetricsDataSourceSetMetrics(t *testing.T) {
+	tests := map[string]struct {
+		body        string
+		wantHasData bool
+		wantFailed  int64
+	}{
+		"metrics":     {body: `{"processed":120,"failed":3,"pending":0}`, wantHasData: true, wantFailed: 3},
+		"idle queue":  {body: `{"processed":0,"failed":0,"pending":0}`, wantHasData: true},
+		"no queue":    {body: `{"processed":null,"failed":null,"pending":null}`},
+		"empty body":  {body: ``},
+		"empty value": {body: `{}`},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/environments/1/queue-metrics" || r.URL.Query().Get("period") != "1d" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := VaporClient{apiHost: server.URL}
+
+			metrics, err := client.GetQueueMetrics(context.Background(), 1, "1d")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			data := QueueMetricsDataSourceModel{}
+
+			data.setMetrics(metrics)
+
+			if data.HasData.ValueBool() != test.wantHasData {
+				t.Errorf("expected has_data %t, got %t", test.wantHasData, data.HasData.ValueBool())
+			}
+
+			if data.Failed.IsNull() || data.Failed.ValueInt64() != test.wantFailed {
+				t.Errorf("expected %d failed jobs, got %s", test.wantFailed, data.Failed)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccRollbackResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/environments/1/rollback": `{"id": 311, "environment_id": 1, "status": "pending"}`,
+		"GET /api/deployments/311":          `{"id": 311, "environment_id": 1, "status": "finished", "url": "https://terraform.vapor.build"}`,
+		"GET /api/deployments/312":          `{"id": 312, "environment_id": 1, "status": "finished", "url": "https://terraform.vapor.build"}`,
+	})
+
+	server.setRouteAfter("POST /api/environments/1/rollback", "POST /api/environments/1/rollback", `{"id": 312, "environment_id": 1, "status": "pending"}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRollbackResourceConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/environments/1/rollback", `{"deployment_id": 1}`),
+					resource.TestCheckResourceAttr("laravelvapor_rollback.test", "id", "311"),
+					resource.TestCheckResourceAttr("laravelvapor_rollback.test", "environment_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_rollback.test", "deployment_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_rollback.test", "status", "finished"),
+					resource.TestCheckResourceAttr("laravelvapor_rollback.test", "url", "https://terraform.vapor.build"),
+				),
+			},
+			// Another target deployment triggers a new rollback
+			{
+				Config: testAccRollbackResourceConfig(2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_rollback.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/environments/1/rollback", `{"deployment_id": 2}`),
+					resource.TestCheckResourceAttr("laravelvapor_rollback.test", "id", "312"),
+					resource.TestCheckResourceAttr("laravelvapor_rollback.test", "deployment_id", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRollbackResourceConfig(deploymentId int) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_rollback" "test" {
+  environment_id = 1
+  deployment_id  = %[1]d
+}
+`, deploymentId)
+}
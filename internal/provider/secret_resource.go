@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecretResource{}
+
+func NewSecretResource() resource.Resource {
+	return &SecretResource{}
+}
+
+// SecretResource defines the resource implementation.
+type SecretResource struct {
+	client VaporClient
+}
+
+// SecretResourceModel describes the resource data model.
+type SecretResourceModel struct {
+	Id            types.Int32  `tfsdk:"id"`
+	EnvironmentId types.Int32  `tfsdk:"environment_id"`
+	Name          types.String `tfsdk:"name"`
+	Value         types.String `tfsdk:"value"`
+}
+
+func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage an encrypted environment secret",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Secret ID",
+				Computed:            true,
+			},
+			"environment_id": schema.Int32Attribute{
+				MarkdownDescription: "Environment ID the secret belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Secret name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// Write-only attributes are not available in this framework version, sensitive is the closest
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Secret value, never returned by the API so changes made outside of Terraform are not detected",
+				Required:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecretResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.CreateSecret(ctx, int(data.EnvironmentId.ValueInt32()), data.Name.ValueString(), data.Value.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create secret", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(secret.Id))
+
+	tflog.Trace(ctx, "created a secret resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecretResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, err := r.client.GetSecrets(ctx, int(data.EnvironmentId.ValueInt32()))
+
+	// Environment was removed outside of Terraform, along with its secrets
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read secrets", err, data.identity()))
+		return
+	}
+
+	var secret *VaporSecret
+
+	for i := range secrets {
+		if secrets[i].Name == data.Name.ValueString() {
+			secret = &secrets[i]
+			break
+		}
+	}
+
+	// Secret was removed outside of Terraform
+	if secret == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Value is kept from prior state, the API does not return it
+	data.Id = types.Int32Value(int32(secret.Id))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SecretResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Storing a secret with the same name replaces its value
+	secret, err := r.client.CreateSecret(ctx, int(data.EnvironmentId.ValueInt32()), data.Name.ValueString(), data.Value.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("update secret", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(secret.Id))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SecretResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveSecret(ctx, int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete secret", err, data.identity()))
+		return
+	}
+}
+
+// identity returns the attributes identifying the secret in diagnostics.
+func (data *SecretResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":             data.Id,
+		"environment_id": data.EnvironmentId,
+		"name":           data.Name,
+	}
+}
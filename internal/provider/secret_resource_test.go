@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSecretResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/environments/1/secrets": `{"id": 41, "environment_id": 1, "name": "DB_PASSWORD", "version": 1}`,
+		"GET /api/environments/1/secrets":  `[]`,
+		"DELETE /api/secrets/42":           ``,
+	})
+
+	server.setRouteAfter("POST /api/environments/1/secrets", "POST /api/environments/1/secrets", `{"id": 42, "environment_id": 1, "name": "DB_PASSWORD", "version": 2}`)
+	server.setRouteAfter("POST /api/environments/1/secrets", "GET /api/environments/1/secrets", `[{"id": 41, "environment_id": 1, "name": "DB_PASSWORD", "version": 1}]`)
+	server.setRouteAfter("POST /api/environments/1/secrets", "GET /api/environments/1/secrets", `[{"id": 42, "environment_id": 1, "name": "DB_PASSWORD", "version": 2}]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSecretResourceConfig("first-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/environments/1/secrets", `{"name": "DB_PASSWORD", "value": "first-password"}`),
+					resource.TestCheckResourceAttr("laravelvapor_secret.test", "id", "41"),
+					resource.TestCheckResourceAttr("laravelvapor_secret.test", "environment_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_secret.test", "name", "DB_PASSWORD"),
+					resource.TestCheckResourceAttr("laravelvapor_secret.test", "value", "first-password"),
+				),
+			},
+			// Rotation testing
+			{
+				Config: testAccSecretResourceConfig("second-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/environments/1/secrets", `{"name": "DB_PASSWORD", "value": "second-password"}`),
+					resource.TestCheckResourceAttr("laravelvapor_secret.test", "id", "42"),
+					resource.TestCheckResourceAttr("laravelvapor_secret.test", "name", "DB_PASSWORD"),
+					resource.TestCheckResourceAttr("laravelvapor_secret.test", "value", "second-password"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSecretResourceConfig(value string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_secret" "test" {
+  environment_id = 1
+  name           = "DB_PASSWORD"
+  value          = %[1]q
+}
+`, value)
+}
+
+func TestSecretResourceReadRemovedEnvironment(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/environments/1/secrets" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not Found."}`))
+	}))
+	defer server.Close()
+
+	r := &SecretResource{client: VaporClient{apiHost: server.URL}}
+	schemaResp := fwresource.SchemaResponse{}
+
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	diags := state.Set(ctx, &SecretResourceModel{
+		Id:            types.Int32Value(41),
+		EnvironmentId: types.Int32Value(1),
+		Name:          types.StringValue("DB_PASSWORD"),
+		Value:         types.StringValue("first-password"),
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := fwresource.ReadResponse{State: state}
+
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected the secret to be removed from state, got %s", resp.State.Raw)
+	}
+}
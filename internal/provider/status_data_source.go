@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StatusDataSource{}
+
+func NewStatusDataSource() datasource.DataSource {
+	return &StatusDataSource{}
+}
+
+// StatusDataSource defines the data source implementation.
+type StatusDataSource struct {
+	client VaporClient
+}
+
+// StatusDataSourceModel describes the data source data model.
+type StatusDataSourceModel struct {
+	Reachable     types.Bool   `tfsdk:"reachable"`
+	Authenticated types.Bool   `tfsdk:"authenticated"`
+	AccountId     types.Int32  `tfsdk:"account_id"`
+	Message       types.String `tfsdk:"message"`
+}
+
+func (d *StatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status"
+}
+
+func (d *StatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Check the Laravel Vapor API is reachable and accepts the configured token. " +
+			"Failures are reported in the attributes instead of failing the plan, so they can be used in preconditions. " +
+			"A token rejected by the default Laravel Vapor host still fails the provider configuration, as it is validated beforehand",
+
+		Attributes: map[string]schema.Attribute{
+			"reachable": schema.BoolAttribute{
+				MarkdownDescription: "Did the API respond",
+				Computed:            true,
+			},
+			"authenticated": schema.BoolAttribute{
+				MarkdownDescription: "Did the API accept the configured token",
+				Computed:            true,
+			},
+			"account_id": schema.Int32Attribute{
+				MarkdownDescription: "Authenticated user ID, null when not authenticated",
+				Computed:            true,
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Error met while checking the API, empty when authenticated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *StatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *StatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := d.client.Ping(ctx)
+
+	data.setStatus(account, err)
+
+	tflog.Trace(ctx, "read status data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setStatus fills the model from a ping result, errors are reported as attributes and never as diagnostics.
+func (data *StatusDataSourceModel) setStatus(account *Account, err error) {
+	var apiErr *APIError
+
+	// Any API response, even a rejection, means the API is reachable
+	data.Reachable = types.BoolValue(err == nil || errors.As(err, &apiErr))
+	data.Authenticated = types.BoolValue(err == nil)
+	data.AccountId = types.Int32Null()
+	data.Message = types.StringValue("")
+
+	if err != nil {
+		data.Message = types.StringValue(err.Error())
+		return
+	}
+
+	data.AccountId = types.Int32Value(int32(account.Id))
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatusDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/user": testFixture(t, "user.json"),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccStatusDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("GET /api/user", ""),
+					resource.TestCheckResourceAttr("data.laravelvapor_status.test", "reachable", "true"),
+					resource.TestCheckResourceAttr("data.laravelvapor_status.test", "authenticated", "true"),
+					resource.TestCheckResourceAttr("data.laravelvapor_status.test", "account_id", "19870"),
+					resource.TestCheckResourceAttr("data.laravelvapor_status.test", "message", ""),
+				),
+			},
+		},
+	})
+}
+
+const testAccStatusDataSourceConfig = `
+data "laravelvapor_status" "test" {}
+`
+
+func TestStatusDataSourceSetStatus(t *testing.T) {
+	tests := map[string]struct {
+		account           *Account
+		err               error
+		wantReachable     bool
+		wantAuthenticated bool
+		wantAccountId     types.Int32
+	}{
+		"authenticated": {
+			account:           &Account{Id: 19870},
+			wantReachable:     true,
+			wantAuthenticated: true,
+			wantAccountId:     types.Int32Value(19870),
+		},
+		"rejected token": {
+			account:       &Account{},
+			err:           &APIError{StatusCode: http.StatusUnauthorized, Message: "Unauthenticated."},
+			wantReachable: true,
+			wantAccountId: types.Int32Null(),
+		},
+		"unreachable": {
+			account:       &Account{},
+			err:           errors.New("dial tcp: connection refused"),
+			wantAccountId: types.Int32Null(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := StatusDataSourceModel{}
+
+			data.setStatus(test.account, test.err)
+
+			if data.Reachable.ValueBool() != test.wantReachable {
+				t.Errorf("expected reachable %t, got %t", test.wantReachable, data.Reachable.ValueBool())
+			}
+
+			if data.Authenticated.ValueBool() != test.wantAuthenticated {
+				t.Errorf("expected authenticated %t, got %t", test.wantAuthenticated, data.Authenticated.ValueBool())
+			}
+
+			if !data.AccountId.Equal(test.wantAccountId) {
+				t.Errorf("expected account ID %s, got %s", test.wantAccountId, data.AccountId)
+			}
+
+			if (test.err != nil) == (data.Message.ValueString() == "") {
+				t.Errorf("unexpected message %q", data.Message.ValueString())
+			}
+		})
+	}
+}
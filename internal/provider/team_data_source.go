@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &TeamDataSource{}
+
+func NewTeamDataSource() datasource.DataSource {
+	return &TeamDataSource{}
+}
+
+// TeamDataSource defines the data source implementation.
+type TeamDataSource struct {
+	client VaporClient
+}
+
+// TeamDataSourceModel describes the data source data model.
+type TeamDataSourceModel struct {
+	Id                       types.Int32  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	AwsId                    types.String `tfsdk:"aws_external_id"`
+	SentryOrganisationName   types.String `tfsdk:"sentry_organization_name"`
+	SentryOrganisationRegion types.String `tfsdk:"sentry_organization_region"`
+	Owner                    types.Object `tfsdk:"owner"`
+}
+
+func (d *TeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Get a team by its ID or name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID, conflicts with `name`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Team name (case-insensitive), conflicts with `id`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"aws_external_id": schema.StringAttribute{
+				MarkdownDescription: "Team AWS external ID",
+				Computed:            true,
+			},
+			"sentry_organization_name": schema.StringAttribute{
+				MarkdownDescription: "Team Sentry organization name",
+				Computed:            true,
+			},
+			"sentry_organization_region": schema.StringAttribute{
+				MarkdownDescription: "Team Sentry organization region",
+				Computed:            true,
+			},
+			"owner": teamOwnerSchemaAttribute(),
+		},
+	}
+}
+
+func (d *TeamDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data TeamDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values might be known only after apply
+	if data.Id.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.Id.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Attribute Combination",
+			"Exactly one of `id` or `name` must be configured.",
+		)
+	}
+}
+
+func (d *TeamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matches := []Team{}
+
+	if !data.Id.IsNull() {
+		team, err := d.client.GetTeam(ctx, int(data.Id.ValueInt32()))
+
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
+			return
+		}
+
+		if err == nil {
+			matches = append(matches, *team)
+		}
+	} else {
+		// Names can only be matched against the whole list
+		teams, err := d.client.GetTeams(ctx)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read teams, got error: %s", err))
+			return
+		}
+
+		for _, team := range teams {
+			if strings.EqualFold(team.Name, data.Name.ValueString()) {
+				matches = append(matches, team)
+			}
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Team Not Found", "No team matches the given `id` or `name`.")
+		return
+	}
+
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError("Multiple Teams Found", fmt.Sprintf("%d teams match the name %q, use `id` instead.", len(matches), data.Name.ValueString()))
+		return
+	}
+
+	team := newTeamModel(matches[0])
+
+	owner, diags := types.ObjectValueFrom(ctx, teamOwnerAttrTypes, team.Owner)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Configured name must be kept as it was given
+	if data.Name.IsNull() {
+		data.Name = team.Name
+	}
+
+	data.Id = team.Id
+	data.AwsId = team.AwsId
+	data.SentryOrganisationName = team.SentryOrganisationName
+	data.SentryOrganisationRegion = team.SentryOrganisationRegion
+	data.Owner = owner
+
+	tflog.Trace(ctx, "read team data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/teams":       `[{"id": 79169, "name": "Terraformers", "aws_external_id": "vapor-79169", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}, {"id": 79170, "name": "Website", "owner": {"id": 19871, "name": "Member", "email": "member@example.com"}}]`,
+		"GET /api/teams/79169": `{"id": 79169, "name": "Terraformers", "aws_external_id": "vapor-79169", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read by name testing
+			{
+				Config: testAccTeamDataSourceByNameConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("GET /api/teams", ""),
+					resource.TestCheckResourceAttr("data.laravelvapor_team.test", "id", "79169"),
+					resource.TestCheckResourceAttr("data.laravelvapor_team.test", "name", "terraformers"),
+					resource.TestCheckResourceAttr("data.laravelvapor_team.test", "aws_external_id", "vapor-79169"),
+					resource.TestCheckResourceAttr("data.laravelvapor_team.test", "owner.email", "ruben@example.com"),
+				),
+			},
+			// Read by ID testing
+			{
+				Config: testAccTeamDataSourceByIdConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("GET /api/teams/79169", ""),
+					resource.TestCheckResourceAttr("data.laravelvapor_team.test", "name", "Terraformers"),
+					resource.TestCheckResourceAttr("data.laravelvapor_team.test", "owner.id", "19870"),
+				),
+			},
+			// Invalid configuration testing
+			{
+				Config:      testAccTeamDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+const testAccTeamDataSourceByNameConfig = `
+data "laravelvapor_team" "test" {
+  name = "terraformers"
+}
+`
+
+const testAccTeamDataSourceByIdConfig = `
+data "laravelvapor_team" "test" {
+  id = 79169
+}
+`
+
+const testAccTeamDataSourceInvalidConfig = `
+data "laravelvapor_team" "test" {}
+`
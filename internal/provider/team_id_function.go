@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &TeamIdFunction{}
+
+func NewTeamIdFunction(provider *LaravelVaporProvider) function.Function {
+	return &TeamIdFunction{
+		provider: provider,
+	}
+}
+
+// TeamIdFunction defines the function implementation.
+type TeamIdFunction struct {
+	provider *LaravelVaporProvider
+}
+
+func (f *TeamIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "team_id"
+}
+
+func (f *TeamIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Look up a team ID by name",
+		MarkdownDescription: "Look up the ID of the team with the given name, fails unless exactly one team matches. Terraform may call functions before the provider is configured, the `LARAVEL_VAPOR_TOKEN` and `LARAVEL_VAPOR_HOST` environment variables are used then",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Team name",
+			},
+		},
+		Return: function.Int32Return{},
+	}
+}
+
+func (f *TeamIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+
+	if resp.Error != nil {
+		return
+	}
+
+	client := f.client()
+
+	teams, err := client.GetTeams(ctx)
+
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to read teams, got error: %s", err))
+		return
+	}
+
+	var matches []Team
+
+	for _, team := range teams {
+		if team.Name == name {
+			matches = append(matches, team)
+		}
+	}
+
+	if len(matches) != 1 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Expected exactly one team named %q, found %d", name, len(matches)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, int32(matches[0].Id)))
+}
+
+// client returns the configured provider client, or one built from the environment when unconfigured.
+func (f *TeamIdFunction) client() VaporClient {
+	if f.provider != nil && f.provider.client != nil {
+		return *f.provider.client
+	}
+
+	return VaporClient{
+		apiToken:       os.Getenv("LARAVEL_VAPOR_TOKEN"),
+		apiHost:        os.Getenv("LARAVEL_VAPOR_HOST"),
+		MaxRetries:     defaultMaxRetries,
+		RetryBaseDelay: defaultRetryBaseDelay,
+		Http:           http.Client{Timeout: defaultRequestTimeout},
+	}
+}
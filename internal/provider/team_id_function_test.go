@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTeamIdFunction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/teams" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`[{"id": 1, "name": "Personal"}, {"id": 2, "name": "Acme"}, {"id": 3, "name": "Shared"}, {"id": 4, "name": "Shared"}]`))
+	}))
+	defer server.Close()
+
+	tests := map[string]struct {
+		name      string
+		expected  int32
+		wantError bool
+	}{
+		"unique match": {name: "Acme", expected: 2},
+		"no match":     {name: "Unknown", wantError: true},
+		"many matches": {name: "Shared", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := &LaravelVaporProvider{client: &VaporClient{apiHost: server.URL}}
+
+			resp := runTeamIdFunction(p, test.name)
+
+			if test.wantError {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %s", resp.Result.Value())
+				}
+
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			if !resp.Result.Value().Equal(types.Int32Value(test.expected)) {
+				t.Errorf("expected %d, got %s", test.expected, resp.Result.Value())
+			}
+		})
+	}
+
+	t.Run("unconfigured provider", func(t *testing.T) {
+		t.Setenv("LARAVEL_VAPOR_HOST", server.URL)
+
+		resp := runTeamIdFunction(&LaravelVaporProvider{}, "Personal")
+
+		if resp.Error != nil {
+			t.Fatalf("unexpected error: %s", resp.Error)
+		}
+
+		if !resp.Result.Value().Equal(types.Int32Value(1)) {
+			t.Errorf("expected 1, got %s", resp.Result.Value())
+		}
+	})
+}
+
+func runTeamIdFunction(p *LaravelVaporProvider, name string) function.RunResponse {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(name),
+		}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.Int32Unknown()),
+	}
+
+	NewTeamIdFunction(p).Run(context.Background(), req, &resp)
+
+	return resp
+}
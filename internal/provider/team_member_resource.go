@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamMemberResource{}
+
+func NewTeamMemberResource() resource.Resource {
+	return &TeamMemberResource{}
+}
+
+// TeamMemberResource defines the resource implementation.
+type TeamMemberResource struct {
+	client VaporClient
+}
+
+// TeamMemberResourceModel describes the resource data model.
+type TeamMemberResourceModel struct {
+	Id          types.Int32  `tfsdk:"id"`
+	TeamId      types.Int32  `tfsdk:"team_id"`
+	Email       types.String `tfsdk:"email"`
+	Permissions types.List   `tfsdk:"permissions"`
+}
+
+func (r *TeamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+func (r *TeamMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a member of a team",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Member user ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID the member belongs to, defaults to the provider `team_id`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Member email",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Member permissions within the team, the `laravelvapor_team_permissions` data source lists the valid ones",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(teamPermissions...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId, diags := teamIdOrDefault(data.TeamId, r.client)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamId = teamId
+
+	var permissions []string
+
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.client.AddTeamMember(ctx, int(data.TeamId.ValueInt32()), data.Email.ValueString(), permissions)
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("add team member", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(member.Id))
+
+	tflog.Trace(ctx, "created a team member resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.client.GetTeamMembers(ctx, int(data.TeamId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read team members", err, data.identity()))
+		return
+	}
+
+	var member *Account
+
+	for i := range members {
+		if strings.EqualFold(members[i].Email, data.Email.ValueString()) {
+			member = &members[i]
+			break
+		}
+	}
+
+	// Member was removed outside of Terraform
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.Int32Value(int32(member.Id))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var permissions []string
+
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateTeamMember(ctx, int(data.TeamId.ValueInt32()), data.Email.ValueString(), permissions)
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("update team member", err, data.identity()))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.RemoveTeamMember(ctx, int(data.TeamId.ValueInt32()), data.Email.ValueString())
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("remove team member", err, data.identity()))
+		return
+	}
+}
+
+// identity returns the attributes identifying the team member in diagnostics.
+func (data *TeamMemberResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"team_id": data.TeamId,
+		"email":   data.Email,
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccTeamMemberResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/teams/79169/members":   `{"id": 19871, "name": "Member", "email": "member@example.com"}`,
+		"GET /api/teams/79169/members":    `[{"id": 19870, "name": "Ruben", "email": "ruben@example.com"}]`,
+		"PUT /api/teams/79169/members":    `{"id": 19871, "name": "Member", "email": "member@example.com"}`,
+		"DELETE /api/teams/79169/members": `{"id": 19871, "name": "Member", "email": "member@example.com"}`,
+	})
+
+	server.setRouteAfter("POST /api/teams/79169/members", "GET /api/teams/79169/members", `[{"id": 19870, "name": "Ruben", "email": "ruben@example.com"}, {"id": 19871, "name": "Member", "email": "member@example.com"}]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		CheckDestroy:             server.checkRequest("DELETE /api/teams/79169/members", `{"email": "member@example.com"}`),
+		Steps: []resource.TestStep{
+			// Unknown permission testing
+			{
+				Config:      testAccTeamMemberResourceConfig("member@example.com", "view-project"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccTeamMemberResourceConfig("member@example.com", "view-projects"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/teams/79169/members", `{"email": "member@example.com", "permissions": ["view-projects"]}`),
+					resource.TestCheckResourceAttr("laravelvapor_team_member.test", "id", "19871"),
+					resource.TestCheckResourceAttr("laravelvapor_team_member.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("laravelvapor_team_member.test", "email", "member@example.com"),
+					resource.TestCheckResourceAttr("laravelvapor_team_member.test", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_team_member.test", "permissions.0", "view-projects"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamMemberResourceConfig("member@example.com", "deploy-projects"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_team_member.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/teams/79169/members", `{"email": "member@example.com", "permissions": ["deploy-projects"]}`),
+					resource.TestCheckResourceAttr("laravelvapor_team_member.test", "permissions.0", "deploy-projects"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamMemberResourceConfig(email string, permission string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_team_member" "test" {
+  team_id     = 79169
+  email       = %[1]q
+  permissions = [%[2]q]
+}
+`, email, permission)
+}
+
+func TestTeamMemberResourcePermissionsValidation(t *testing.T) {
+	schemaResp := fwresource.SchemaResponse{}
+
+	NewTeamMemberResource().Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	validators := schemaResp.Schema.Attributes["permissions"].(schema.ListAttribute).Validators
+
+	tests := map[string]struct {
+		permissions []string
+		wantError   bool
+	}{
+		"known":   {permissions: []string{"view-projects", "deploy-projects"}},
+		"empty":   {permissions: []string{}},
+		"typo":    {permissions: []string{"view-projects", "deploy-project"}, wantError: true},
+		"unknown": {permissions: []string{"manage-everything"}, wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			permissions, diags := types.ListValueFrom(context.Background(), types.StringType, test.permissions)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			resp := validator.ListResponse{}
+
+			for _, v := range validators {
+				v.ValidateList(context.Background(), validator.ListRequest{ConfigValue: permissions}, &resp)
+			}
+
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Errorf("expected validation error to be %t, got diagnostics: %v", test.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamMembersDataSource{}
+
+func NewTeamMembersDataSource() datasource.DataSource {
+	return &TeamMembersDataSource{}
+}
+
+// TeamMembersDataSource defines the data source implementation.
+type TeamMembersDataSource struct {
+	client VaporClient
+}
+
+// TeamMembersDataSourceModel describes the data source data model.
+type TeamMembersDataSourceModel struct {
+	TeamId  types.Int32 `tfsdk:"team_id"`
+	Members types.List  `tfsdk:"members"`
+}
+
+// TeamMemberModel describes a team member object in data source models.
+type TeamMemberModel struct {
+	Id              types.Int32  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Email           types.String `tfsdk:"email"`
+	EmailVerifiedAt types.String `tfsdk:"email_verified_at"`
+}
+
+var teamMemberAttrTypes = map[string]attr.Type{
+	"id":                types.Int32Type,
+	"name":              types.StringType,
+	"email":             types.StringType,
+	"email_verified_at": types.StringType,
+}
+
+func (d *TeamMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (d *TeamMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "List all members of a team",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID",
+				Required:            true,
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "Team members list",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Member user ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Member name",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Member email",
+							Computed:            true,
+						},
+						"email_verified_at": schema.StringAttribute{
+							MarkdownDescription: "Member email verified date time",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamMembersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.GetTeamMembers(ctx, int(data.TeamId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team members, got error: %s", err))
+		return
+	}
+
+	memberModels := []TeamMemberModel{}
+
+	for _, member := range members {
+		memberModels = append(memberModels, TeamMemberModel{
+			Id:              types.Int32Value(int32(member.Id)),
+			Name:            types.StringValue(member.Name),
+			Email:           types.StringValue(member.Email),
+			EmailVerifiedAt: types.StringValue(member.EmailVerifiedAt),
+		})
+	}
+
+	membersValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: teamMemberAttrTypes}, memberModels)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Members = membersValue
+
+	tflog.Trace(ctx, "read team members data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamMembersDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/teams/79169/members": `[{"id": 19870, "name": "Ruben", "email": "ruben@example.com", "email_verified_at": "2024-01-10T09:00:00.000000Z"}, {"id": 19871, "name": "Member", "email": "member@example.com"}]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamMembersDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_team_members.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("data.laravelvapor_team_members.test", "members.#", "2"),
+					resource.TestCheckResourceAttr("data.laravelvapor_team_members.test", "members.0.id", "19870"),
+					resource.TestCheckResourceAttr("data.laravelvapor_team_members.test", "members.0.email", "ruben@example.com"),
+					resource.TestCheckResourceAttr("data.laravelvapor_team_members.test", "members.0.email_verified_at", "2024-01-10T09:00:00.000000Z"),
+					resource.TestCheckResourceAttr("data.laravelvapor_team_members.test", "members.1.name", "Member"),
+					resource.TestCheckResourceAttr("data.laravelvapor_team_members.test", "members.1.email_verified_at", ""),
+				),
+			},
+		},
+	})
+}
+
+const testAccTeamMembersDataSourceConfig = `
+data "laravelvapor_team_members" "test" {
+  team_id = 79169
+}
+`
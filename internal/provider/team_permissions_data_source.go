@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamPermissionsDataSource{}
+
+func NewTeamPermissionsDataSource() datasource.DataSource {
+	return &TeamPermissionsDataSource{}
+}
+
+// TeamPermissionsDataSource defines the data source implementation.
+type TeamPermissionsDataSource struct{}
+
+// TeamPermissionsDataSourceModel describes the data source data model.
+type TeamPermissionsDataSourceModel struct {
+	Permissions types.List `tfsdk:"permissions"`
+}
+
+func (d *TeamPermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_permissions"
+}
+
+func (d *TeamPermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Get the permissions team members can be granted. " +
+			"The Vapor API does not list them, this is the set the provider knows about, as offered by the Vapor CLI `member:add` command, " +
+			"and the one `laravelvapor_team_member` permissions are validated against.",
+
+		Attributes: map[string]schema.Attribute{
+			"permissions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Team member permission slugs",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TeamPermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamPermissionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no API endpoint listing permissions, the known ones are returned instead
+	permissions, diags := types.ListValueFrom(ctx, types.StringType, teamPermissions)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Permissions = permissions
+
+	tflog.Trace(ctx, "read team permissions data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
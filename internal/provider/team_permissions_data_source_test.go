@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamPermissionsDataSource(t *testing.T) {
+	// Permissions are listed without any request
+	server := newTestServer(t, testRoutes{})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamPermissionsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_team_permissions.test", "permissions.#", strconv.Itoa(len(teamPermissions))),
+					resource.TestCheckTypeSetElemAttr("data.laravelvapor_team_permissions.test", "permissions.*", "deploy-projects"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTeamPermissionsDataSourceConfig = `
+data "laravelvapor_team_permissions" "test" {}
+`
@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamResource{}
+
+func NewTeamResource() resource.Resource {
+	return &TeamResource{}
+}
+
+// TeamResource defines the resource implementation.
+type TeamResource struct {
+	client VaporClient
+}
+
+// TeamResourceModel describes the resource data model.
+type TeamResourceModel struct {
+	Id                       types.Int32  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	SentryOrganizationName   types.String `tfsdk:"sentry_organization_name"`
+	SentryOrganizationRegion types.String `tfsdk:"sentry_organization_region"`
+	ForceDestroy             types.Bool   `tfsdk:"force_destroy"`
+}
+
+func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a team owned by the authenticated account",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Team name",
+				Required:            true,
+			},
+			"sentry_organization_name": schema.StringAttribute{
+				MarkdownDescription: "Sentry organization name used for error tracking, kept as it is when not configured, set it to an empty string to remove it",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sentry_organization_region": schema.StringAttribute{
+				MarkdownDescription: "Sentry organization region used for error tracking, kept as it is when not configured, set it to an empty string to remove it",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Remove the remaining team members on destroy, except the owner and the current user, otherwise the team cannot be deleted while it has members",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := r.client.CreateTeam(ctx, data.toTeam())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create team", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(team.Id))
+	data.setSentry(team)
+
+	tflog.Trace(ctx, "created a team resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := r.client.GetTeam(ctx, int(data.Id.ValueInt32()))
+
+	// Team was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read team", err, data.identity()))
+		return
+	}
+
+	data.Name = types.StringValue(team.Name)
+	data.SentryOrganizationName = types.StringValue(team.SentryOrganisationName)
+	data.SentryOrganizationRegion = types.StringValue(team.SentryOrganisationRegion)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := r.client.UpdateTeam(ctx, int(data.Id.ValueInt32()), data.toTeam())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("update team", err, data.identity()))
+		return
+	}
+
+	data.setSentry(team)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId := int(data.Id.ValueInt32())
+
+	// Remaining members block the team deletion
+	if data.ForceDestroy.ValueBool() {
+		team, err := r.client.GetTeam(ctx, teamId)
+
+		// Already removed outside of Terraform
+		if isNotFound(err) {
+			return
+		}
+
+		if err != nil {
+			resp.Diagnostics.Append(newClientError("read team", err, data.identity()))
+			return
+		}
+
+		account, err := r.client.GetAccount(ctx)
+
+		if err != nil {
+			resp.Diagnostics.Append(newClientError("read account", err, data.identity()))
+			return
+		}
+
+		members, err := r.client.GetTeamMembers(ctx, teamId)
+
+		if err != nil {
+			resp.Diagnostics.Append(newClientError("read team members", err, data.identity()))
+			return
+		}
+
+		for _, member := range members {
+			// The owner and the current user cannot be removed, they leave along with the team
+			if member.Id == team.Owner.Id || member.Id == account.Id {
+				continue
+			}
+
+			_, err := r.client.RemoveTeamMember(ctx, teamId, member.Email)
+
+			if err != nil && !isNotFound(err) {
+				resp.Diagnostics.Append(newClientError(fmt.Sprintf("remove team member %q", member.Email), err, data.identity()))
+				return
+			}
+		}
+	}
+
+	err := r.client.RemoveTeam(ctx, teamId)
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete team", err, data.identity()))
+		return
+	}
+}
+
+// toTeam returns the team to send, Sentry settings are left out on creation until known and kept from state on updates.
+func (data *TeamResourceModel) toTeam() Team {
+	return Team{
+		Name:                     data.Name.ValueString(),
+		SentryOrganisationName:   data.SentryOrganizationName.ValueString(),
+		SentryOrganisationRegion: data.SentryOrganizationRegion.ValueString(),
+	}
+}
+
+// setSentry fills the Sentry settings not configured with the API ones.
+func (data *TeamResourceModel) setSentry(team *Team) {
+	if data.SentryOrganizationName.IsUnknown() {
+		data.SentryOrganizationName = types.StringValue(team.SentryOrganisationName)
+	}
+
+	if data.SentryOrganizationRegion.IsUnknown() {
+		data.SentryOrganizationRegion = types.StringValue(team.SentryOrganisationRegion)
+	}
+}
+
+// identity returns the attributes identifying the team in diagnostics.
+func (data *TeamResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":   data.Id,
+		"name": data.Name,
+	}
+}
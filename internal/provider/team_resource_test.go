@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccTeamResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/owned-teams":           `{"id": 79200, "name": "Terraform Team", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}`,
+		"GET /api/teams/79200":            `{"id": 79200, "name": "Terraform Team", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}`,
+		"PUT /api/teams/79200":            `{"id": 79200, "name": "Terraform Team Renamed", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}`,
+		"GET /api/user":                   testFixture(t, "user.json"),
+		"GET /api/teams/79200/members":    `[{"id": 19870, "name": "Ruben", "email": "ruben@example.com"}, {"id": 19871, "name": "Member", "email": "member@example.com"}]`,
+		"DELETE /api/teams/79200/members": `{"id": 19871, "name": "Member", "email": "member@example.com"}`,
+		"DELETE /api/teams/79200":         ``,
+	})
+
+	server.setRouteAfter("PUT /api/teams/79200", "GET /api/teams/79200", `{"id": 79200, "name": "Terraform Team Renamed", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}`)
+	server.setRouteAfter("PUT /api/teams/79200", "PUT /api/teams/79200", `{"id": 79200, "name": "Terraform Team Renamed", "sentry_organization_name": "terraform", "sentry_organization_region": "us", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}`)
+	server.setRouteAfter("PUT /api/teams/79200", "GET /api/teams/79200", `{"id": 79200, "name": "Terraform Team Renamed", "sentry_organization_name": "terraform", "sentry_organization_region": "us", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}`)
+	server.setRouteAfter("PUT /api/teams/79200", "PUT /api/teams/79200", `{"id": 79200, "name": "Terraform Team Renamed", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}`)
+	server.setRouteAfter("PUT /api/teams/79200", "GET /api/teams/79200", `{"id": 79200, "name": "Terraform Team Renamed", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		// Force destroy removes the members other than the owner before the team
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			server.checkRequest("DELETE /api/teams/79200/members", `{"email": "member@example.com"}`),
+			server.checkRequestCount("DELETE /api/teams/79200/members", 1),
+			server.checkRequest("DELETE /api/teams/79200", ""),
+		),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamResourceConfig("Terraform Team"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/owned-teams", `{"name": "Terraform Team"}`),
+					resource.TestCheckResourceAttr("laravelvapor_team.test", "id", "79200"),
+					resource.TestCheckResourceAttr("laravelvapor_team.test", "name", "Terraform Team"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamResourceConfig("Terraform Team Renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_team.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/teams/79200", `{"name": "Terraform Team Renamed", "sentry_organization_name": "", "sentry_organization_region": ""}`),
+					resource.TestCheckResourceAttr("laravelvapor_team.test", "name", "Terraform Team Renamed"),
+				),
+			},
+			// Sentry update testing
+			{
+				Config: testAccTeamResourceSentryConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_team.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/teams/79200", `{"name": "Terraform Team Renamed", "sentry_organization_name": "terraform", "sentry_organization_region": "us"}`),
+					resource.TestCheckResourceAttr("laravelvapor_team.test", "sentry_organization_name", "terraform"),
+					resource.TestCheckResourceAttr("laravelvapor_team.test", "sentry_organization_region", "us"),
+					resource.TestCheckResourceAttr("laravelvapor_team.test", "force_destroy", "true"),
+				),
+			},
+			// Sentry removal testing
+			{
+				Config: testAccTeamResourceSentryRemovedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_team.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/teams/79200", `{"name": "Terraform Team Renamed", "sentry_organization_name": "", "sentry_organization_region": ""}`),
+					resource.TestCheckResourceAttr("laravelvapor_team.test", "sentry_organization_name", ""),
+					resource.TestCheckResourceAttr("laravelvapor_team.test", "sentry_organization_region", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_team" "test" {
+  name = %[1]q
+}
+`, name)
+}
+
+const testAccTeamResourceSentryConfig = `
+resource "laravelvapor_team" "test" {
+  name                       = "Terraform Team Renamed"
+  sentry_organization_name   = "terraform"
+  sentry_organization_region = "us"
+  force_destroy              = true
+}
+`
+
+const testAccTeamResourceSentryRemovedConfig = `
+resource "laravelvapor_team" "test" {
+  name                       = "Terraform Team Renamed"
+  sentry_organization_name   = ""
+  sentry_organization_region = ""
+  force_destroy              = true
+}
+`
+
+func TestTeamResourceForceDestroyKeepsOwnerAndCurrentUser(t *testing.T) {
+	ctx := context.Background()
+
+	var removed []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/teams/1":
+			_, _ = w.Write([]byte(`{"id": 1, "name": "Acme", "owner": {"id": 10, "email": "owner@example.com"}}`))
+		case "GET /api/user":
+			_, _ = w.Write([]byte(`{"id": 11, "email": "terraform@example.com"}`))
+		case "GET /api/teams/1/members":
+			_, _ = w.Write([]byte(`[
+				{"id": 10, "email": "owner@example.com"},
+				{"id": 11, "email": "terraform@example.com"},
+				{"id": 12, "email": "developer@example.com"}
+			]`))
+		case "DELETE /api/teams/1/members":
+			member := Account{}
+
+			_ = json.NewDecoder(r.Body).Decode(&member)
+
+			removed = append(removed, member.Email)
+
+			_, _ = w.Write([]byte(`{}`))
+		case "DELETE /api/teams/1":
+			removed = append(removed, "team")
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	r := &TeamResource{client: VaporClient{apiHost: server.URL}}
+	schemaResp := fwresource.SchemaResponse{}
+
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	diags := state.Set(ctx, &TeamResourceModel{
+		Id:                       types.Int32Value(1),
+		Name:                     types.StringValue("Acme"),
+		SentryOrganizationName:   types.StringNull(),
+		SentryOrganizationRegion: types.StringNull(),
+		ForceDestroy:             types.BoolValue(true),
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := fwresource.DeleteResponse{State: state}
+
+	r.Delete(ctx, fwresource.DeleteRequest{State: state}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if !slices.Equal(removed, []string{"developer@example.com", "team"}) {
+		t.Errorf("expected only the other members to be removed before the team, got %q", removed)
+	}
+}
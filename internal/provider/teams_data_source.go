@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamsDataSource{}
+
+func NewTeamsDataSource() datasource.DataSource {
+	return &TeamsDataSource{}
+}
+
+// TeamsDataSource defines the data source implementation.
+type TeamsDataSource struct {
+	client VaporClient
+}
+
+// TeamsDataSourceModel describes the data source data model.
+type TeamsDataSourceModel struct {
+	Teams types.List `tfsdk:"teams"`
+}
+
+// TeamModel describes a team object in data source models.
+type TeamModel struct {
+	Id                       types.Int32    `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	AwsId                    types.String   `tfsdk:"aws_external_id"`
+	SentryOrganisationName   types.String   `tfsdk:"sentry_organization_name"`
+	SentryOrganisationRegion types.String   `tfsdk:"sentry_organization_region"`
+	Owner                    TeamOwnerModel `tfsdk:"owner"`
+}
+
+// TeamOwnerModel describes the owner of a team object.
+type TeamOwnerModel struct {
+	Id    types.Int32  `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+}
+
+var teamOwnerAttrTypes = map[string]attr.Type{
+	"id":    types.Int32Type,
+	"name":  types.StringType,
+	"email": types.StringType,
+}
+
+var teamAttrTypes = map[string]attr.Type{
+	"id":                         types.Int32Type,
+	"name":                       types.StringType,
+	"aws_external_id":            types.StringType,
+	"sentry_organization_name":   types.StringType,
+	"sentry_organization_region": types.StringType,
+	"owner":                      types.ObjectType{AttrTypes: teamOwnerAttrTypes},
+}
+
+func newTeamModel(team Team) TeamModel {
+	return TeamModel{
+		Id:                       types.Int32Value(int32(team.Id)),
+		Name:                     types.StringValue(team.Name),
+		AwsId:                    types.StringValue(team.AwsId),
+		SentryOrganisationName:   types.StringValue(team.SentryOrganisationName),
+		SentryOrganisationRegion: types.StringValue(team.SentryOrganisationRegion),
+		Owner: TeamOwnerModel{
+			Id:    types.Int32Value(int32(team.Owner.Id)),
+			Name:  types.StringValue(team.Owner.Name),
+			Email: types.StringValue(team.Owner.Email),
+		},
+	}
+}
+
+func teamOwnerSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Team owner",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Team owner user ID",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Team owner name",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Team owner email",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *TeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "List all teams accessible by the current user",
+
+		Attributes: map[string]schema.Attribute{
+			"teams": schema.ListNestedAttribute{
+				MarkdownDescription: "Teams list",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Team ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Team name",
+							Computed:            true,
+						},
+						"aws_external_id": schema.StringAttribute{
+							MarkdownDescription: "Team AWS external ID",
+							Computed:            true,
+						},
+						"sentry_organization_name": schema.StringAttribute{
+							MarkdownDescription: "Team Sentry organization name",
+							Computed:            true,
+						},
+						"sentry_organization_region": schema.StringAttribute{
+							MarkdownDescription: "Team Sentry organization region",
+							Computed:            true,
+						},
+						"owner": teamOwnerSchemaAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teams, err := d.client.GetTeams(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read teams, got error: %s", err))
+		return
+	}
+
+	teamModels := []TeamModel{}
+
+	for _, team := range teams {
+		teamModels = append(teamModels, newTeamModel(team))
+	}
+
+	teamsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: teamAttrTypes}, teamModels)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Teams = teamsValue
+
+	tflog.Trace(ctx, "read teams data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamsDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/teams": `[{"id": 79169, "name": "Terraformers", "aws_external_id": "vapor-79169", "owner": {"id": 19870, "name": "Ruben", "email": "ruben@example.com"}}, {"id": 79170, "name": "Website", "owner": {"id": 19871, "name": "Member", "email": "member@example.com"}}]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_teams.test", "teams.#", "2"),
+					resource.TestCheckResourceAttr("data.laravelvapor_teams.test", "teams.0.id", "79169"),
+					resource.TestCheckResourceAttr("data.laravelvapor_teams.test", "teams.0.name", "Terraformers"),
+					resource.TestCheckResourceAttr("data.laravelvapor_teams.test", "teams.0.owner.email", "ruben@example.com"),
+					resource.TestCheckResourceAttr("data.laravelvapor_teams.test", "teams.1.id", "79170"),
+					resource.TestCheckResourceAttr("data.laravelvapor_teams.test", "teams.1.owner.id", "19871"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTeamsDataSourceConfig = `
+data "laravelvapor_teams" "test" {}
+`
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ZoneDataSource{}
+
+func NewZoneDataSource() datasource.DataSource {
+	return &ZoneDataSource{}
+}
+
+// ZoneDataSource defines the data source implementation.
+type ZoneDataSource struct {
+	client VaporClient
+}
+
+// ZoneDataSourceModel describes the data source data model.
+type ZoneDataSourceModel struct {
+	Id                types.Int32  `tfsdk:"id"`
+	TeamId            types.Int32  `tfsdk:"team_id"`
+	Zone              types.String `tfsdk:"zone"`
+	CloudProviderId   types.Int32  `tfsdk:"cloud_provider_id"`
+	Nameservers       types.List   `tfsdk:"nameservers"`
+	SesVerified       types.Bool   `tfsdk:"ses_verified"`
+	PendingSesRecords types.List   `tfsdk:"pending_ses_records"`
+	Importing         types.Bool   `tfsdk:"importing"`
+	RecordsCount      types.Int32  `tfsdk:"records_count"`
+	CloudProvider     types.Object `tfsdk:"cloud_provider"`
+}
+
+// ZoneCloudProviderModel describes the cloud provider nested in a zone.
+type ZoneCloudProviderModel struct {
+	Id      types.Int32  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Uuid    types.String `tfsdk:"uuid"`
+	RoleArn types.String `tfsdk:"role_arn"`
+}
+
+var zoneCloudProviderAttrTypes = map[string]attr.Type{
+	"id":       types.Int32Type,
+	"name":     types.StringType,
+	"type":     types.StringType,
+	"uuid":     types.StringType,
+	"role_arn": types.StringType,
+}
+
+func (d *ZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone"
+}
+
+func (d *ZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Get a DNS zone by its ID or domain name",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Zone ID, conflicts with `zone`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID to look the zone up in, required along with `zone`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Zone domain name, conflicts with `id`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"cloud_provider_id": schema.Int32Attribute{
+				MarkdownDescription: "Cloud provider ID the zone is created in",
+				Computed:            true,
+			},
+			"nameservers": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Zone nameservers",
+				Computed:            true,
+			},
+			"ses_verified": schema.BoolAttribute{
+				MarkdownDescription: "Is the zone verified for sending emails through SES",
+				Computed:            true,
+			},
+			"pending_ses_records": schema.ListNestedAttribute{
+				MarkdownDescription: "DNS records SES still requires to verify the zone, empty once it is verified. They can be created in the registrar hosting the domain",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Record type",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Record name",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Record value",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"importing": schema.BoolAttribute{
+				MarkdownDescription: "Is the zone still being imported, its DNS records are not available until it is done",
+				Computed:            true,
+			},
+			"records_count": schema.Int32Attribute{
+				MarkdownDescription: "Number of DNS records in the zone",
+				Computed:            true,
+			},
+			"cloud_provider": schema.SingleNestedAttribute{
+				MarkdownDescription: "Cloud provider the zone is created in",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int32Attribute{
+						MarkdownDescription: "Cloud provider ID",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Cloud provider name",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Cloud provider type",
+						Computed:            true,
+					},
+					"uuid": schema.StringAttribute{
+						MarkdownDescription: "Cloud provider UUID",
+						Computed:            true,
+					},
+					"role_arn": schema.StringAttribute{
+						MarkdownDescription: "Cloud provider IAM role ARN",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZoneDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ZoneDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values might be known only after apply
+	if data.Id.IsUnknown() || data.Zone.IsUnknown() || data.TeamId.IsUnknown() {
+		return
+	}
+
+	if data.Id.IsNull() == data.Zone.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Attribute Combination",
+			"Exactly one of `id` or `zone` must be configured.",
+		)
+
+		return
+	}
+
+	if !data.Zone.IsNull() && data.TeamId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("team_id"),
+			"Missing Attribute Configuration",
+			"`team_id` must be configured when looking up a zone by `zone`.",
+		)
+	}
+}
+
+func (d *ZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneId := int(data.Id.ValueInt32())
+
+	if data.Id.IsNull() {
+		zones, err := d.client.GetZones(ctx, int(data.TeamId.ValueInt32()))
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zones, got error: %s", err))
+			return
+		}
+
+		for _, zone := range zones {
+			if strings.EqualFold(zone.Zone, data.Zone.ValueString()) {
+				zoneId = int(zone.Id)
+				break
+			}
+		}
+
+		if zoneId == 0 {
+			resp.Diagnostics.AddError("Zone Not Found", fmt.Sprintf("No zone matches the domain name %q.", data.Zone.ValueString()))
+			return
+		}
+	}
+
+	// Listed zones might not include all details, always fetch the zone itself
+	zone, err := d.client.GetZone(ctx, zoneId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zone, got error: %s", err))
+		return
+	}
+
+	nameservers, diags := nameserversValue(ctx, zone.Nameservers)
+
+	resp.Diagnostics.Append(diags...)
+
+	pendingSesRecords, diags := sesRecordsValue(ctx, zone.pendingSesRecords())
+
+	resp.Diagnostics.Append(diags...)
+
+	cloudProvider, diags := types.ObjectValueFrom(ctx, zoneCloudProviderAttrTypes, ZoneCloudProviderModel{
+		Id:      types.Int32Value(int32(zone.CloudProvider.Id)),
+		Name:    types.StringValue(zone.CloudProvider.Name),
+		Type:    types.StringValue(zone.CloudProvider.Type),
+		Uuid:    types.StringValue(zone.CloudProvider.Uuid),
+		RoleArn: types.StringValue(zone.CloudProvider.RoleArn),
+	})
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Configured values must be kept as they were given
+	if data.TeamId.IsNull() {
+		data.TeamId = types.Int32Value(int32(zone.TeamId))
+	}
+
+	if data.Zone.IsNull() {
+		data.Zone = types.StringValue(zone.Zone)
+	}
+
+	data.Id = types.Int32Value(int32(zone.Id))
+	data.CloudProviderId = types.Int32Value(int32(zone.CloudProviderId))
+	data.Nameservers = nameservers
+	data.SesVerified = types.BoolValue(zone.SesVerified)
+	data.PendingSesRecords = pendingSesRecords
+	data.Importing = types.BoolValue(zone.Importing)
+	data.RecordsCount = types.Int32Value(int32(zone.RecordsCount))
+	data.CloudProvider = cloudProvider
+
+	tflog.Trace(ctx, "read zone data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccZoneDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/teams/79169/zones": `[{"id": 1, "team_id": 79169, "zone": "example.com"}, {"id": 2, "team_id": 79169, "zone": "example.org"}]`,
+		"GET /api/zones/1":           `{"id": 1, "team_id": 79169, "cloud_provider_id": 1, "zone": "example.com", "nameservers": ["ns-1.awsdns-01.org", "ns-2.awsdns-02.com"], "ses_verified": false, "records_count": 4, "cloud_provider": {"id": 1, "name": "production", "type": "aws", "uuid": "2b6e3f9a", "role_arn": "arn:aws:iam::123456789012:role/laravel-vapor-role"}, "ses_dns_records": [{"type": "CNAME", "name": "abc._domainkey.example.com", "value": "abc.dkim.amazonses.com"}]}`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read by domain name testing
+			{
+				Config: testAccZoneDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("GET /api/zones/1", ""),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "zone", "example.com"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "id", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "cloud_provider.id", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "cloud_provider.role_arn", "arn:aws:iam::123456789012:role/laravel-vapor-role"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "nameservers.#", "2"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "nameservers.0", "ns-1.awsdns-01.org"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "importing", "false"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "records_count", "4"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "ses_verified", "false"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "pending_ses_records.#", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone.test", "pending_ses_records.0.value", "abc.dkim.amazonses.com"),
+				),
+			},
+			// Invalid configuration testing
+			{
+				Config:      testAccZoneDataSourceMissingTeamConfig,
+				ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+			},
+		},
+	})
+}
+
+const testAccZoneDataSourceConfig = `
+data "laravelvapor_zone" "test" {
+  team_id = 79169
+  zone    = "example.com"
+}
+`
+
+const testAccZoneDataSourceMissingTeamConfig = `
+data "laravelvapor_zone" "test" {
+  zone = "example.com"
+}
+`
@@ -0,0 +1,429 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneRecordResource{}
+var _ resource.ResourceWithValidateConfig = &ZoneRecordResource{}
+var _ resource.ResourceWithImportState = &ZoneRecordResource{}
+
+func NewZoneRecordResource() resource.Resource {
+	return &ZoneRecordResource{}
+}
+
+// ZoneRecordResource defines the resource implementation.
+type ZoneRecordResource struct {
+	client VaporClient
+}
+
+// ZoneRecordResourceModel describes the resource data model.
+type ZoneRecordResourceModel struct {
+	Id       types.Int32  `tfsdk:"id"`
+	ZoneId   types.Int32  `tfsdk:"zone_id"`
+	Type     types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	Value    types.String `tfsdk:"value"`
+	Ttl      types.Int32  `tfsdk:"ttl"`
+	Priority types.Int32  `tfsdk:"priority"`
+}
+
+func (r *ZoneRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_record"
+}
+
+func (r *ZoneRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a DNS record of a zone",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Record ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.Int32Attribute{
+				MarkdownDescription: "Zone ID the record belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Record type, one of `A`, `AAAA`, `CAA`, `CNAME`, `MX`, `NS`, `PTR`, `SPF`, `SRV` or `TXT`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(zoneRecordTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Record name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Record value",
+				Required:            true,
+			},
+			"ttl": schema.Int32Attribute{
+				MarkdownDescription: "Record TTL in seconds, defaults to the Laravel Vapor one",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"priority": schema.Int32Attribute{
+				MarkdownDescription: "Record priority, required for `MX` records and only allowed for them",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (r *ZoneRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ZoneRecordResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values might be known only after apply
+	if data.Type.IsUnknown() || data.Priority.IsUnknown() {
+		return
+	}
+
+	if data.Type.ValueString() == "MX" && data.Priority.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("priority"),
+			"Missing Attribute Configuration",
+			"`priority` must be configured for MX records.",
+		)
+	}
+
+	if data.Type.ValueString() != "MX" && !data.Priority.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("priority"),
+			"Invalid Attribute Configuration",
+			"`priority` can only be configured for MX records.",
+		)
+	}
+}
+
+func (r *ZoneRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record, err := r.client.CreateZoneRecord(ctx, data.toRecord())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create zone record", err, data.identity()))
+		return
+	}
+
+	data.Id = types.Int32Value(int32(record.Id))
+
+	// Vapor applies its own TTL when none is given
+	if data.Ttl.IsUnknown() {
+		data.Ttl = types.Int32Value(int32(record.Ttl))
+	}
+
+	tflog.Trace(ctx, "created a zone record resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := r.client.GetZoneRecords(ctx, int(data.ZoneId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read zone records", err, data.identity()))
+		return
+	}
+
+	record := findZoneRecord(records, data.toRecord())
+
+	// Record was removed outside of Terraform
+	if record == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.Int32Value(int32(record.Id))
+
+	// Values only normalized by the API are kept as configured, so they do not show a diff
+	if !sameZoneRecordValue(record.Type, record.Value, data.Value.ValueString()) {
+		data.Value = types.StringValue(record.Value)
+	}
+
+	if record.Ttl != 0 {
+		data.Ttl = types.Int32Value(int32(record.Ttl))
+	}
+
+	if record.Priority != nil {
+		data.Priority = types.Int32Value(int32(*record.Priority))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ZoneRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Value, TTL and priority are updated in place, avoiding a gap without the record
+	record, err := r.client.UpdateZoneRecord(ctx, data.toRecord())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("update zone record", err, data.identity()))
+		return
+	}
+
+	if record.Ttl != 0 {
+		data.Ttl = types.Int32Value(int32(record.Ttl))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ZoneRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveZoneRecord(ctx, data.toRecord())
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete zone record", err, data.identity()))
+		return
+	}
+}
+
+func (r *ZoneRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Record values might contain slashes, keep everything after the name as the value
+	parts := strings.SplitN(req.ID, "/", 4)
+
+	if len(parts) != 4 || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with format: zoneId/type/name/value, got: %q", req.ID),
+		)
+
+		return
+	}
+
+	zoneId, err := strconv.Atoi(parts[0])
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a numeric zone ID, got: %q", parts[0]),
+		)
+
+		return
+	}
+
+	records, err := r.client.GetZoneRecords(ctx, zoneId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zone records, got error: %s", err))
+		return
+	}
+
+	record := findZoneRecord(records, VaporZoneRecord{Type: parts[1], Name: parts[2], Value: parts[3]})
+
+	if record == nil {
+		resp.Diagnostics.AddError(
+			"Zone Record Not Found",
+			fmt.Sprintf("No %s record named %q with value %q exists in zone %d.", parts[1], parts[2], parts[3], zoneId),
+		)
+
+		return
+	}
+
+	data := ZoneRecordResourceModel{
+		Id:     types.Int32Value(int32(record.Id)),
+		ZoneId: types.Int32Value(int32(zoneId)),
+		Type:   types.StringValue(record.Type),
+		Name:   types.StringValue(record.Name),
+		Value:  types.StringValue(record.Value),
+		Ttl:    types.Int32Value(int32(record.Ttl)),
+	}
+
+	if record.Priority != nil {
+		data.Priority = types.Int32Value(int32(*record.Priority))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (data *ZoneRecordResourceModel) toRecord() VaporZoneRecord {
+	record := VaporZoneRecord{
+		Id:     FlexInt(data.Id.ValueInt32()),
+		ZoneId: FlexInt(data.ZoneId.ValueInt32()),
+		Type:   data.Type.ValueString(),
+		Name:   data.Name.ValueString(),
+		Value:  data.Value.ValueString(),
+		Ttl:    int(data.Ttl.ValueInt32()),
+	}
+
+	if record.Type == "MX" && !data.Priority.IsNull() {
+		priority := int(data.Priority.ValueInt32())
+		record.Priority = &priority
+	}
+
+	return record
+}
+
+// findZoneRecord returns the record matching the given one by id, or by type, name and value when no id matches.
+func findZoneRecord(records []VaporZoneRecord, record VaporZoneRecord) *VaporZoneRecord {
+	// Duplicated records must not shadow the one with the same id
+	if record.Id != 0 {
+		for i := range records {
+			if records[i].Id == record.Id {
+				return &records[i]
+			}
+		}
+	}
+
+	for i := range records {
+		if sameZoneRecord(records[i], record) {
+			return &records[i]
+		}
+	}
+
+	return nil
+}
+
+// sameZoneRecord reports whether two records have the same type, name and value, and priority for MX records.
+// Names and hostname values are compared ignoring case and a trailing dot, as the API might normalize them.
+func sameZoneRecord(a VaporZoneRecord, b VaporZoneRecord) bool {
+	if !strings.EqualFold(a.Type, b.Type) || normalizeRecordName(a.Name) != normalizeRecordName(b.Name) {
+		return false
+	}
+
+	if !sameZoneRecordValue(a.Type, a.Value, b.Value) {
+		return false
+	}
+
+	if !strings.EqualFold(a.Type, "MX") || a.Priority == nil || b.Priority == nil {
+		return true
+	}
+
+	return *a.Priority == *b.Priority
+}
+
+// sameZoneRecordValue reports whether two values of a record type are the same, hostnames ignoring case and a trailing dot.
+func sameZoneRecordValue(recordType string, a string, b string) bool {
+	// TXT values are free text where case matters
+	if strings.EqualFold(recordType, "TXT") {
+		return a == b
+	}
+
+	return normalizeRecordName(a) == normalizeRecordName(b)
+}
+
+// normalizeRecordName lowercases a record name or hostname value and removes its trailing dot.
+func normalizeRecordName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// identity returns the attributes identifying the zone record in diagnostics.
+func (data *ZoneRecordResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":      data.Id,
+		"zone_id": data.ZoneId,
+		"type":    data.Type,
+		"name":    data.Name,
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccZoneRecordResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/zones/1/records":    `{"id": 101, "zone_id": 1, "type": "TXT", "name": "@", "value": "v=spf1 include:amazonses.com ~all", "ttl": 300}`,
+		"GET /api/zones/1/records":     `[]`,
+		"PUT /api/zones/1/records/101": `{"id": 101, "zone_id": 1, "type": "TXT", "name": "@", "value": "v=spf1 include:amazonses.com -all", "ttl": 300}`,
+		"DELETE /api/zones/1/records":  ``,
+	})
+
+	server.setRouteAfter("POST /api/zones/1/records", "GET /api/zones/1/records", `[{"id": 101, "zone_id": 1, "type": "TXT", "name": "@", "value": "v=spf1 include:amazonses.com ~all", "ttl": 300}]`)
+	server.setRouteAfter("PUT /api/zones/1/records/101", "GET /api/zones/1/records", `[{"id": 101, "zone_id": 1, "type": "TXT", "name": "@", "value": "v=spf1 include:amazonses.com -all", "ttl": 300}]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		CheckDestroy:             server.checkRequestCount("DELETE /api/zones/1/records", 1),
+		Steps: []resource.TestStep{
+			// Invalid type testing
+			{
+				Config:      testAccZoneRecordResourceInvalidTypeConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// MX records need a priority
+			{
+				Config:      testAccZoneRecordResourceMissingPriorityConfig,
+				ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccZoneRecordResourceConfig("v=spf1 include:amazonses.com ~all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/zones/1/records", `{"zone_id": 1, "type": "TXT", "name": "@", "value": "v=spf1 include:amazonses.com ~all", "ttl": 300}`),
+					resource.TestCheckResourceAttr("laravelvapor_zone_record.test", "id", "101"),
+					resource.TestCheckResourceAttr("laravelvapor_zone_record.test", "type", "TXT"),
+					resource.TestCheckResourceAttr("laravelvapor_zone_record.test", "value", "v=spf1 include:amazonses.com ~all"),
+					resource.TestCheckResourceAttr("laravelvapor_zone_record.test", "ttl", "300"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "laravelvapor_zone_record.test",
+				ImportState:       true,
+				ImportStateId:     "1/TXT/@/v=spf1 include:amazonses.com ~all",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccZoneRecordResourceConfig("v=spf1 include:amazonses.com -all"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_zone_record.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("PUT /api/zones/1/records/101", `{"value": "v=spf1 include:amazonses.com -all", "ttl": 300}`),
+					resource.TestCheckResourceAttr("laravelvapor_zone_record.test", "id", "101"),
+					resource.TestCheckResourceAttr("laravelvapor_zone_record.test", "value", "v=spf1 include:amazonses.com -all"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccZoneRecordResourceConfig(value string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_zone_record" "test" {
+  zone_id = 1
+  type    = "TXT"
+  name    = "@"
+  value   = %[1]q
+  ttl     = 300
+}
+`, value)
+}
+
+const testAccZoneRecordResourceInvalidTypeConfig = `
+resource "laravelvapor_zone_record" "test" {
+  zone_id = 1
+  type    = "TXTT"
+  name    = "@"
+  value   = "v=spf1 include:amazonses.com ~all"
+}
+`
+
+const testAccZoneRecordResourceMissingPriorityConfig = `
+resource "laravelvapor_zone_record" "test" {
+  zone_id = 1
+  type    = "MX"
+  name    = "@"
+  value   = "inbound-smtp.us-east-1.amazonaws.com"
+}
+`
+
+func TestZoneRecordResourceModelToRecordPriority(t *testing.T) {
+	mx := ZoneRecordResourceModel{Type: types.StringValue("MX"), Priority: types.Int32Value(0)}
+
+	if record := mx.toRecord(); record.Priority == nil || *record.Priority != 0 {
+		t.Errorf("expected MX record priority 0 to be sent, got %v", record.Priority)
+	}
+
+	txt := ZoneRecordResourceModel{Type: types.StringValue("TXT"), Priority: types.Int32Value(10)}
+
+	if record := txt.toRecord(); record.Priority != nil {
+		t.Errorf("expected TXT record priority not to be sent, got %d", *record.Priority)
+	}
+}
+
+func TestZoneRecordResourceTypeValidation(t *testing.T) {
+	schemaResp := fwresource.SchemaResponse{}
+
+	NewZoneRecordResource().Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	validators := schemaResp.Schema.Attributes["type"].(schema.StringAttribute).Validators
+
+	for value, wantError := range map[string]bool{"TXT": false, "MX": false, "txt": true, "TXTT": true} {
+		resp := validator.StringResponse{}
+
+		for _, v := range validators {
+			v.ValidateString(context.Background(), validator.StringRequest{ConfigValue: types.StringValue(value)}, &resp)
+		}
+
+		if resp.Diagnostics.HasError() != wantError {
+			t.Errorf("expected validation error for %q to be %t, got diagnostics: %v", value, wantError, resp.Diagnostics)
+		}
+	}
+}
+
+func TestFindZoneRecordNormalizes(t *testing.T) {
+	ten, twenty := 10, 20
+
+	existing := []VaporZoneRecord{
+		{Id: 1, Type: "CNAME", Name: "WWW.example.com.", Value: "Example.com."},
+		{Id: 2, Type: "TXT", Name: "example.com", Value: "Verification=ABC"},
+		{Id: 3, Type: "MX", Name: "example.com", Value: "mail.example.com", Priority: &twenty},
+		{Id: 4, Type: "A", Name: "api.example.com", Value: "127.0.0.1"},
+		{Id: 5, Type: "A", Name: "api.example.com", Value: "127.0.0.1"},
+	}
+
+	tests := map[string]struct {
+		record   VaporZoneRecord
+		expected FlexInt
+	}{
+		"name and value case and trailing dot": {record: VaporZoneRecord{Type: "CNAME", Name: "www.example.com", Value: "example.com"}, expected: 1},
+		"txt value case kept":                  {record: VaporZoneRecord{Type: "TXT", Name: "example.com", Value: "verification=abc"}, expected: 0},
+		"txt value":                            {record: VaporZoneRecord{Type: "TXT", Name: "example.com.", Value: "Verification=ABC"}, expected: 2},
+		"mx priority mismatch":                 {record: VaporZoneRecord{Type: "MX", Name: "example.com", Value: "mail.example.com", Priority: &ten}, expected: 0},
+		"mx priority":                          {record: VaporZoneRecord{Type: "MX", Name: "example.com", Value: "mail.example.com", Priority: &twenty}, expected: 3},
+		"priority ignored for other types":     {record: VaporZoneRecord{Type: "A", Name: "api.example.com", Value: "127.0.0.1", Priority: &ten}, expected: 4},
+		"id preferred over duplicates":         {record: VaporZoneRecord{Id: 5, Type: "A", Name: "api.example.com", Value: "127.0.0.1"}, expected: 5},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			found := findZoneRecord(existing, test.record)
+
+			if test.expected == 0 && found != nil {
+				t.Fatalf("expected no match, got record %d", found.Id)
+			}
+
+			if test.expected != 0 && (found == nil || found.Id != test.expected) {
+				t.Fatalf("expected record %d, got %+v", test.expected, found)
+			}
+		})
+	}
+}
+
+func TestZoneRecordResourceReadRefreshesValue(t *testing.T) {
+	ctx := context.Background()
+
+	server := newTestServer(t, testRoutes{
+		"GET /api/zones/1/records": `[
+			{"id": 7, "zone_id": 1, "type": "CNAME", "name": "www", "value": "Example.com.", "ttl": 300},
+			{"id": 8, "zone_id": 1, "type": "CNAME", "name": "api", "value": "changed.example.com", "ttl": 300}
+		]`,
+	})
+
+	r := &ZoneRecordResource{client: VaporClient{apiHost: server.URL}}
+	schemaResp := fwresource.SchemaResponse{}
+
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := map[string]struct {
+		id       int32
+		name     string
+		expected string
+	}{
+		"normalized value kept as configured": {id: 7, name: "www", expected: "example.com"},
+		"value changed outside of terraform":  {id: 8, name: "api", expected: "changed.example.com"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+			diags := state.Set(ctx, &ZoneRecordResourceModel{
+				Id:       types.Int32Value(test.id),
+				ZoneId:   types.Int32Value(1),
+				Type:     types.StringValue("CNAME"),
+				Name:     types.StringValue(test.name),
+				Value:    types.StringValue("example.com"),
+				Ttl:      types.Int32Value(300),
+				Priority: types.Int32Null(),
+			})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			resp := fwresource.ReadResponse{State: state}
+
+			r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var data ZoneRecordResourceModel
+
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+
+			if data.Value.ValueString() != test.expected {
+				t.Errorf("expected value %q, got %s", test.expected, data.Value)
+			}
+		})
+	}
+}
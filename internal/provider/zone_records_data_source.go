@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneRecordsDataSource{}
+
+func NewZoneRecordsDataSource() datasource.DataSource {
+	return &ZoneRecordsDataSource{}
+}
+
+// ZoneRecordsDataSource defines the data source implementation.
+type ZoneRecordsDataSource struct {
+	client VaporClient
+}
+
+// ZoneRecordsDataSourceModel describes the data source data model.
+type ZoneRecordsDataSourceModel struct {
+	ZoneId  types.Int32 `tfsdk:"zone_id"`
+	Records types.List  `tfsdk:"records"`
+}
+
+// ZoneRecordModel describes a zone record object in data source models.
+type ZoneRecordModel struct {
+	Id    types.Int32  `tfsdk:"id"`
+	Type  types.String `tfsdk:"type"`
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+var zoneRecordAttrTypes = map[string]attr.Type{
+	"id":    types.Int32Type,
+	"type":  types.StringType,
+	"name":  types.StringType,
+	"value": types.StringType,
+}
+
+func (d *ZoneRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_records"
+}
+
+func (d *ZoneRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "List all DNS records of a zone",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int32Attribute{
+				MarkdownDescription: "Zone ID",
+				Required:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "Zone records list, ordered by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Record ID",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Record type",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Record name",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Record value",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZoneRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZoneRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneRecordsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := d.client.GetZoneRecords(ctx, int(data.ZoneId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zone records, got error: %s", err))
+		return
+	}
+
+	// Keep a stable order between reads to prevent spurious diffs
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Id < records[j].Id
+	})
+
+	recordModels := []ZoneRecordModel{}
+
+	for _, record := range records {
+		recordModels = append(recordModels, ZoneRecordModel{
+			Id:    types.Int32Value(int32(record.Id)),
+			Type:  types.StringValue(record.Type),
+			Name:  types.StringValue(record.Name),
+			Value: types.StringValue(record.Value),
+		})
+	}
+
+	recordsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: zoneRecordAttrTypes}, recordModels)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Records = recordsValue
+
+	tflog.Trace(ctx, "read zone records data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccZoneRecordsDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/zones/1/records": `[{"id": 102, "zone_id": 1, "type": "CNAME", "name": "www", "value": "example.com"}, {"id": 101, "zone_id": 1, "type": "A", "name": "@", "value": "127.0.0.1"}]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccZoneRecordsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_records.test", "zone_id", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_records.test", "records.#", "2"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_records.test", "records.0.id", "101"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_records.test", "records.0.type", "A"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_records.test", "records.0.name", "@"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_records.test", "records.0.value", "127.0.0.1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_records.test", "records.1.id", "102"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_records.test", "records.1.type", "CNAME"),
+				),
+			},
+		},
+	})
+}
+
+const testAccZoneRecordsDataSourceConfig = `
+data "laravelvapor_zone_records" "test" {
+  zone_id = 1
+}
+`
@@ -0,0 +1,479 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneRecordsResource{}
+var _ resource.ResourceWithValidateConfig = &ZoneRecordsResource{}
+
+func NewZoneRecordsResource() resource.Resource {
+	return &ZoneRecordsResource{}
+}
+
+// ZoneRecordsResource defines the resource implementation.
+type ZoneRecordsResource struct {
+	client VaporClient
+}
+
+// ZoneRecordsResourceModel describes the resource data model.
+type ZoneRecordsResourceModel struct {
+	Id      types.Int32 `tfsdk:"id"`
+	ZoneId  types.Int32 `tfsdk:"zone_id"`
+	Records types.Set   `tfsdk:"records"`
+}
+
+// ZoneRecordsRecordModel describes a record object in zone records models.
+type ZoneRecordsRecordModel struct {
+	Type     types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	Value    types.String `tfsdk:"value"`
+	Ttl      types.Int32  `tfsdk:"ttl"`
+	Priority types.Int32  `tfsdk:"priority"`
+}
+
+var zoneRecordsRecordAttrTypes = map[string]attr.Type{
+	"type":     types.StringType,
+	"name":     types.StringType,
+	"value":    types.StringType,
+	"ttl":      types.Int32Type,
+	"priority": types.Int32Type,
+}
+
+func (r *ZoneRecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_records"
+}
+
+func (r *ZoneRecordsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a set of DNS records of a zone together, records are created concurrently. " +
+			"Records of the zone missing from the set are left untouched",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Zone ID the records belong to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.Int32Attribute{
+				MarkdownDescription: "Zone ID the records belong to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.SetNestedAttribute{
+				MarkdownDescription: "Zone records",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Record type, one of `A`, `AAAA`, `CAA`, `CNAME`, `MX`, `NS`, `PTR`, `SPF`, `SRV` or `TXT`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(zoneRecordTypes...),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Record name",
+							Required:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Record value",
+							Required:            true,
+						},
+						"ttl": schema.Int32Attribute{
+							MarkdownDescription: "Record TTL in seconds, defaults to the Laravel Vapor one",
+							Optional:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"priority": schema.Int32Attribute{
+							MarkdownDescription: "Record priority, required for `MX` records and only allowed for them",
+							Optional:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ZoneRecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ZoneRecordsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Records.IsUnknown() {
+		return
+	}
+
+	for _, element := range data.Records.Elements() {
+		object, ok := element.(types.Object)
+
+		if !ok || object.IsUnknown() {
+			continue
+		}
+
+		var record ZoneRecordsRecordModel
+
+		resp.Diagnostics.Append(object.As(ctx, &record, basetypes.ObjectAsOptions{})...)
+
+		// Values might be known only after apply
+		if resp.Diagnostics.HasError() || record.Type.IsUnknown() || record.Priority.IsUnknown() {
+			continue
+		}
+
+		priorityPath := path.Root("records").AtSetValue(element).AtName("priority")
+
+		if record.Type.ValueString() == "MX" && record.Priority.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				priorityPath,
+				"Missing Attribute Configuration",
+				"`priority` must be configured for MX records.",
+			)
+		}
+
+		if record.Type.ValueString() != "MX" && !record.Priority.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				priorityPath,
+				"Invalid Attribute Configuration",
+				"`priority` can only be configured for MX records.",
+			)
+		}
+	}
+}
+
+func (r *ZoneRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneRecordsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := zoneRecordsFromSet(ctx, data.Records)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.ZoneId
+
+	// Nothing was sent in dry run mode, the planned records are kept
+	if r.client.dryRun {
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	created, err := r.createRecords(ctx, int(data.ZoneId.ValueInt32()), planned)
+
+	// Records created before an error are kept in state so they are not leaked
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create zone records", err, data.identity()))
+	}
+
+	records, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: zoneRecordsRecordAttrTypes}, created)
+
+	resp.Diagnostics.Append(diags...)
+
+	data.Records = records
+
+	tflog.Trace(ctx, "created a zone records resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneRecordsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := zoneRecordsFromSet(ctx, data.Records)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.GetZoneRecords(ctx, int(data.ZoneId.ValueInt32()))
+
+	// Zone was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read zone records", err, data.identity()))
+		return
+	}
+
+	// Records removed outside of Terraform are dropped so they are created again
+	kept := []ZoneRecordsRecordModel{}
+
+	for _, record := range managed {
+		if findZoneRecord(existing, record.toRecord(0)) != nil {
+			kept = append(kept, record)
+		}
+	}
+
+	records, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: zoneRecordsRecordAttrTypes}, kept)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Records = records
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ZoneRecordsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing is sent in dry run mode, the planned records are kept
+	if r.client.dryRun {
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	planned, diags := zoneRecordsFromSet(ctx, data.Records)
+
+	resp.Diagnostics.Append(diags...)
+
+	current, diags := zoneRecordsFromSet(ctx, state.Records)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneId := int(data.ZoneId.ValueInt32())
+	kept := []ZoneRecordsRecordModel{}
+
+	// Changed records are removed first, so a record only changing its TTL can be created again
+	for _, record := range current {
+		if containsZoneRecordsRecord(planned, record) {
+			kept = append(kept, record)
+			continue
+		}
+
+		err := r.client.RemoveZoneRecord(ctx, record.toRecord(zoneId))
+
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.Append(newClientError("delete zone record", err, data.identity()))
+			kept = append(kept, record)
+		}
+	}
+
+	added := []ZoneRecordsRecordModel{}
+
+	for _, record := range planned {
+		if !containsZoneRecordsRecord(current, record) {
+			added = append(added, record)
+		}
+	}
+
+	if !resp.Diagnostics.HasError() {
+		created, err := r.createRecords(ctx, zoneId, added)
+
+		if err != nil {
+			resp.Diagnostics.Append(newClientError("create zone records", err, data.identity()))
+		}
+
+		kept = append(kept, created...)
+	}
+
+	// Only records known to exist are saved when a change failed
+	records, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: zoneRecordsRecordAttrTypes}, kept)
+
+	resp.Diagnostics.Append(diags...)
+
+	data.Records = records
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ZoneRecordsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, diags := zoneRecordsFromSet(ctx, data.Records)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, record := range records {
+		err := r.client.RemoveZoneRecord(ctx, record.toRecord(int(data.ZoneId.ValueInt32())))
+
+		// Already removed outside of Terraform
+		if isNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			resp.Diagnostics.Append(newClientError("delete zone record", err, data.identity()))
+			return
+		}
+	}
+}
+
+// createRecords creates the given records and returns the ones that were created, as planned.
+// Records are matched by position, the API might send them back in a different shape.
+func (r *ZoneRecordsResource) createRecords(ctx context.Context, zoneId int, records []ZoneRecordsRecordModel) ([]ZoneRecordsRecordModel, error) {
+	if len(records) == 0 {
+		return []ZoneRecordsRecordModel{}, nil
+	}
+
+	vaporRecords := make([]VaporZoneRecord, 0, len(records))
+
+	for _, record := range records {
+		vaporRecords = append(vaporRecords, record.toRecord(zoneId))
+	}
+
+	created, err := r.client.CreateZoneRecords(ctx, zoneId, vaporRecords)
+
+	createdRecords := []ZoneRecordsRecordModel{}
+
+	for i, record := range records {
+		if created[i] != nil {
+			createdRecords = append(createdRecords, record)
+		}
+	}
+
+	return createdRecords, err
+}
+
+func (record ZoneRecordsRecordModel) toRecord(zoneId int) VaporZoneRecord {
+	vaporRecord := VaporZoneRecord{
+		ZoneId: FlexInt(zoneId),
+		Type:   record.Type.ValueString(),
+		Name:   record.Name.ValueString(),
+		Value:  record.Value.ValueString(),
+		Ttl:    int(record.Ttl.ValueInt32()),
+	}
+
+	if vaporRecord.Type == "MX" && !record.Priority.IsNull() {
+		priority := int(record.Priority.ValueInt32())
+		vaporRecord.Priority = &priority
+	}
+
+	return vaporRecord
+}
+
+func zoneRecordsFromSet(ctx context.Context, set types.Set) ([]ZoneRecordsRecordModel, diag.Diagnostics) {
+	records := []ZoneRecordsRecordModel{}
+
+	diags := set.ElementsAs(ctx, &records, false)
+
+	return records, diags
+}
+
+func containsZoneRecordsRecord(records []ZoneRecordsRecordModel, record ZoneRecordsRecordModel) bool {
+	for _, candidate := range records {
+		if !candidate.Type.Equal(record.Type) || !candidate.Name.Equal(record.Name) || !candidate.Value.Equal(record.Value) ||
+			!candidate.Ttl.Equal(record.Ttl) {
+			continue
+		}
+
+		// Priority is only sent for MX records
+		if record.Type.ValueString() != "MX" || candidate.Priority.Equal(record.Priority) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// identity returns the attributes identifying the zone records in diagnostics.
+func (data *ZoneRecordsResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"zone_id": data.ZoneId,
+	}
+}
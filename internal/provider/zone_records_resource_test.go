@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccZoneRecordsResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/zones/1/records":   `{"id": 101, "zone_id": 1}`,
+		"GET /api/zones/1/records":    `[]`,
+		"DELETE /api/zones/1/records": ``,
+	})
+
+	// Records are created concurrently, the listing changes on the first creation and on the removal of the changed record
+	server.setRouteAfter("POST /api/zones/1/records", "GET /api/zones/1/records", `[{"id": 101, "zone_id": 1, "type": "A", "name": "@", "value": "127.0.0.1"}, {"id": 102, "zone_id": 1, "type": "CNAME", "name": "www", "value": "example.com", "ttl": 300}, {"id": 103, "zone_id": 1, "type": "MX", "name": "@", "value": "inbound-smtp.us-east-1.amazonaws.com", "priority": 10}]`)
+	server.setRouteAfter("DELETE /api/zones/1/records", "GET /api/zones/1/records", `[{"id": 101, "zone_id": 1, "type": "A", "name": "@", "value": "127.0.0.2"}, {"id": 102, "zone_id": 1, "type": "CNAME", "name": "www", "value": "example.com", "ttl": 300}, {"id": 103, "zone_id": 1, "type": "MX", "name": "@", "value": "inbound-smtp.us-east-1.amazonaws.com", "priority": 10}]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		CheckDestroy:             server.checkRequestCount("DELETE /api/zones/1/records", 4),
+		Steps: []resource.TestStep{
+			// Invalid priority testing
+			{
+				Config:      testAccZoneRecordsResourceInvalidPriorityConfig,
+				ExpectError: regexp.MustCompile("`priority` can only be configured for MX records"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccZoneRecordsResourceConfig("127.0.0.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequestCount("POST /api/zones/1/records", 3),
+					resource.TestCheckResourceAttr("laravelvapor_zone_records.test", "id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_zone_records.test", "zone_id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_zone_records.test", "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("laravelvapor_zone_records.test", "records.*", map[string]string{
+						"type":  "A",
+						"name":  "@",
+						"value": "127.0.0.1",
+					}),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccZoneRecordsResourceConfig("127.0.0.2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_zone_records.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequestCount("DELETE /api/zones/1/records", 1),
+					server.checkRequestCount("POST /api/zones/1/records", 4),
+					server.checkRequest("POST /api/zones/1/records", `{"zone_id": 1, "type": "A", "name": "@", "value": "127.0.0.2"}`),
+					resource.TestCheckResourceAttr("laravelvapor_zone_records.test", "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("laravelvapor_zone_records.test", "records.*", map[string]string{
+						"type":  "A",
+						"value": "127.0.0.2",
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccZoneRecordsResourceConfig(address string) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_zone_records" "test" {
+  zone_id = 1
+
+  records = [
+    {
+      type  = "A"
+      name  = "@"
+      value = %[1]q
+    },
+    {
+      type  = "CNAME"
+      name  = "www"
+      value = "example.com"
+      ttl   = 300
+    },
+    {
+      type     = "MX"
+      name     = "@"
+      value    = "inbound-smtp.us-east-1.amazonaws.com"
+      priority = 10
+    },
+  ]
+}
+`, address)
+}
+
+const testAccZoneRecordsResourceInvalidPriorityConfig = `
+resource "laravelvapor_zone_records" "test" {
+  zone_id = 1
+
+  records = [
+    {
+      type     = "TXT"
+      name     = "@"
+      value    = "v=spf1 include:amazonses.com ~all"
+      priority = 10
+    },
+  ]
+}
+`
+
+func TestZoneRecordsResourceCreateRecordsKeepsPlannedRecords(t *testing.T) {
+	// Created records are sent back normalized and without the MX priority
+	server := newTestServer(t, testRoutes{
+		"POST /api/zones/1/records": `{"id": 5, "zone_id": 1, "type": "MX", "name": "example.com.", "value": "INBOUND-SMTP.US-EAST-1.AMAZONAWS.COM."}`,
+	})
+
+	r := &ZoneRecordsResource{client: VaporClient{apiHost: server.URL}}
+
+	planned := []ZoneRecordsRecordModel{
+		{
+			Type:     types.StringValue("MX"),
+			Name:     types.StringValue("@"),
+			Value:    types.StringValue("inbound-smtp.us-east-1.amazonaws.com"),
+			Ttl:      types.Int32Value(300),
+			Priority: types.Int32Value(10),
+		},
+	}
+
+	created, err := r.createRecords(context.Background(), 1, planned)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(created) != 1 || !containsZoneRecordsRecord(created, planned[0]) {
+		t.Errorf("expected the planned record to be kept as it is, got %+v", created)
+	}
+}
+
+func TestZoneRecordsResourceDryRun(t *testing.T) {
+	ctx := context.Background()
+
+	// Unknown routes fail the test, nothing must be sent or read back
+	server := newTestServer(t, testRoutes{})
+
+	r := &ZoneRecordsResource{client: VaporClient{apiHost: server.URL, dryRun: true}}
+	schemaResp := fwresource.SchemaResponse{}
+
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	records, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: zoneRecordsRecordAttrTypes}, []ZoneRecordsRecordModel{
+		{
+			Type:     types.StringValue("MX"),
+			Name:     types.StringValue("@"),
+			Value:    types.StringValue("inbound-smtp.us-east-1.amazonaws.com"),
+			Ttl:      types.Int32Value(300),
+			Priority: types.Int32Value(10),
+		},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	if diags := plan.Set(ctx, &ZoneRecordsResourceModel{Id: types.Int32Unknown(), ZoneId: types.Int32Value(1), Records: records}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw.Copy()}}
+
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &createResp)
+
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var data ZoneRecordsResourceModel
+
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &data)...)
+
+	if !data.Records.Equal(records) || data.Id.ValueInt32() != 1 {
+		t.Errorf("expected the planned records to be kept on create, got %s", data.Records)
+	}
+
+	// Updates keep the planned records as well, whatever was saved before
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	if diags := state.Set(ctx, &ZoneRecordsResourceModel{
+		Id:      types.Int32Value(1),
+		ZoneId:  types.Int32Value(1),
+		Records: types.SetValueMust(types.ObjectType{AttrTypes: zoneRecordsRecordAttrTypes}, []attr.Value{}),
+	}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	updateResp := fwresource.UpdateResponse{State: state}
+
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, &updateResp)
+
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", updateResp.Diagnostics)
+	}
+
+	updateResp.Diagnostics.Append(updateResp.State.Get(ctx, &data)...)
+
+	if !data.Records.Equal(records) {
+		t.Errorf("expected the planned records to be kept on update, got %s", data.Records)
+	}
+}
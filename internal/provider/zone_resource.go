@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneResource{}
+var _ resource.ResourceWithValidateConfig = &ZoneResource{}
+var _ resource.ResourceWithImportState = &ZoneResource{}
+
+func NewZoneResource() resource.Resource {
+	return &ZoneResource{}
+}
+
+// ZoneResource defines the resource implementation.
+type ZoneResource struct {
+	client VaporClient
+}
+
+// ZoneResourceModel describes the resource data model.
+type ZoneResourceModel struct {
+	Id              types.Int32    `tfsdk:"id"`
+	TeamId          types.Int32    `tfsdk:"team_id"`
+	CloudProviderId types.Int32    `tfsdk:"cloud_provider_id"`
+	Zone            types.String   `tfsdk:"zone"`
+	Nameservers     types.List     `tfsdk:"nameservers"`
+	SesVerified     types.Bool     `tfsdk:"ses_verified"`
+	Importing       types.Bool     `tfsdk:"importing"`
+	RecordsCount    types.Int32    `tfsdk:"records_count"`
+	WaitForSes      types.Bool     `tfsdk:"wait_for_ses"`
+	SesTimeout      types.String   `tfsdk:"ses_timeout"`
+	WaitForDeletion types.Bool     `tfsdk:"wait_for_deletion"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone"
+}
+
+func (r *ZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manage a DNS zone",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				MarkdownDescription: "Zone ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID the zone belongs to, defaults to the provider `team_id`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_provider_id": schema.Int32Attribute{
+				MarkdownDescription: "Cloud provider ID the zone is created in",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Zone domain name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"nameservers": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Zone nameservers to configure at the domain registrar",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ses_verified": schema.BoolAttribute{
+				MarkdownDescription: "Is the zone verified for sending emails through SES",
+				Computed:            true,
+			},
+			"importing": schema.BoolAttribute{
+				MarkdownDescription: "Is the zone still being imported, its DNS records are not available until it is done",
+				Computed:            true,
+			},
+			"records_count": schema.Int32Attribute{
+				MarkdownDescription: "Number of DNS records in the zone",
+				Computed:            true,
+			},
+			"wait_for_ses": schema.BoolAttribute{
+				MarkdownDescription: "Wait on creation until the zone is verified for sending emails through SES",
+				Optional:            true,
+			},
+			"ses_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the SES verification when `wait_for_ses` is enabled (e.g. `30m`), defaults to the `timeouts` create value",
+				Optional:            true,
+			},
+			"wait_for_deletion": schema.BoolAttribute{
+				MarkdownDescription: "Wait on destroy until the zone deletion is completed, so the same domain can be created again right away",
+				Optional:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ZoneResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	// Values might be known only after apply
+	if resp.Diagnostics.HasError() || data.SesTimeout.IsNull() || data.SesTimeout.IsUnknown() {
+		return
+	}
+
+	if timeout, err := time.ParseDuration(data.SesTimeout.ValueString()); err != nil || timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ses_timeout"),
+			"Invalid SES Timeout",
+			fmt.Sprintf("`ses_timeout` must be a positive duration such as \"30m\", got: %q", data.SesTimeout.ValueString()),
+		)
+	}
+}
+
+func (r *ZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId, diags := teamIdOrDefault(data.TeamId, r.client)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TeamId = teamId
+
+	zone, err := r.client.CreateZone(ctx, int(data.TeamId.ValueInt32()), int(data.CloudProviderId.ValueInt32()), data.Zone.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("create zone", err, data.identity()))
+		return
+	}
+
+	// Nothing was sent in dry run mode, there is nothing to wait for
+	if r.client.dryRun {
+		resp.Diagnostics.Append(data.setComputed(ctx, zone)...)
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	resp.Diagnostics.Append(data.setComputed(ctx, zone)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitForSes.ValueBool() {
+		sesTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Already validated along with the configuration
+		if timeout, err := time.ParseDuration(data.SesTimeout.ValueString()); err == nil && timeout > 0 {
+			sesTimeout = timeout
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, sesTimeout)
+		defer cancel()
+
+		_, err = waitForStatus(waitCtx, defaultPollInterval, []string{"pending"}, func() (string, error) {
+			zone, err = r.client.GetZone(waitCtx, int(data.Id.ValueInt32()))
+
+			if err != nil || !zone.SesVerified {
+				return "pending", err
+			}
+
+			return "verified", nil
+		})
+
+		// Zone is kept in state even when verification does not complete, so it is tainted instead of leaked
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"SES Verification Not Completed",
+				fmt.Sprintf("Zone %s was not verified for sending emails through SES, check its DNS records at the domain registrar: %s", data.Zone.ValueString(), err),
+			)
+		} else {
+			resp.Diagnostics.Append(data.setComputed(ctx, zone)...)
+		}
+	}
+
+	tflog.Trace(ctx, "created a zone resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.client.GetZone(ctx, int(data.Id.ValueInt32()))
+
+	// Zone was removed outside of Terraform
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("read zone", err, data.identity()))
+		return
+	}
+
+	// Imported zones only have their ID in state
+	if zone.TeamId != 0 {
+		data.TeamId = types.Int32Value(int32(zone.TeamId))
+	}
+
+	if zone.CloudProviderId != 0 {
+		data.CloudProviderId = types.Int32Value(int32(zone.CloudProviderId))
+	}
+
+	resp.Diagnostics.Append(data.setComputed(ctx, zone)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ZoneResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only options used on creation or destroy change in place, the zone itself is unchanged upstream
+	data.SesVerified = state.SesVerified
+	data.Importing = state.Importing
+	data.RecordsCount = state.RecordsCount
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveZone(ctx, int(data.Id.ValueInt32()))
+
+	// Already removed outside of Terraform
+	if isNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("delete zone", err, data.identity()))
+		return
+	}
+
+	// Nothing was sent in dry run mode, the zone is never deleted
+	if !data.WaitForDeletion.ValueBool() || r.client.dryRun {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Zones are deleted asynchronously, they are listed until the deletion is done
+	_, err = waitForStatus(waitCtx, defaultPollInterval, []string{"deleting"}, func() (string, error) {
+		zones, err := r.client.GetZones(waitCtx, int(data.TeamId.ValueInt32()))
+
+		if err != nil {
+			return "deleting", err
+		}
+
+		for _, zone := range zones {
+			if zone.Id == FlexInt(data.Id.ValueInt32()) {
+				return "deleting", nil
+			}
+		}
+
+		return "deleted", nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(newClientError("wait for zone deletion", err, data.identity()))
+		return
+	}
+}
+
+func (r *ZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 32)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a numeric zone ID, got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int32(id))...)
+}
+
+func (data *ZoneResourceModel) setComputed(ctx context.Context, zone VaporZone) diag.Diagnostics {
+	nameservers, diags := nameserversValue(ctx, zone.Nameservers)
+
+	data.Id = types.Int32Value(int32(zone.Id))
+
+	// Zone names only normalized by the API are kept as configured, the API one is taken on import
+	if data.Zone.IsNull() || (zone.Zone != "" && normalizeRecordName(zone.Zone) != normalizeRecordName(data.Zone.ValueString())) {
+		data.Zone = types.StringValue(zone.Zone)
+	}
+
+	data.Nameservers = nameservers
+	data.SesVerified = types.BoolValue(zone.SesVerified)
+	data.Importing = types.BoolValue(zone.Importing)
+	data.RecordsCount = types.Int32Value(int32(zone.RecordsCount))
+
+	return diags
+}
+
+// nameserversValue converts zone nameservers to a list, empty while the zone has none yet.
+func nameserversValue(ctx context.Context, nameservers VaporNameservers) (types.List, diag.Diagnostics) {
+	if nameservers == nil {
+		nameservers = VaporNameservers{}
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, []string(nameservers))
+}
+
+// identity returns the attributes identifying the zone in diagnostics.
+func (data *ZoneResourceModel) identity() map[string]attr.Value {
+	return map[string]attr.Value{
+		"id":      data.Id,
+		"team_id": data.TeamId,
+		"zone":    data.Zone,
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccZoneResource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"POST /api/teams/79169/zones": `{"id": 1, "team_id": 79169, "cloud_provider_id": 1, "zone": "example.com", "nameservers": null, "ses_verified": false}`,
+		"GET /api/zones/1":            `{"id": 1, "team_id": 79169, "cloud_provider_id": 1, "zone": "example.com", "nameservers": ["ns-1.awsdns-01.org", "ns-2.awsdns-02.com"], "ses_verified": true, "records_count": 4, "cloud_provider": {"id": 1, "name": "production", "type": "aws", "uuid": "2b6e3f9a", "role_arn": "arn:aws:iam::123456789012:role/laravel-vapor-role"}}`,
+		"DELETE /api/zones/1":         ``,
+		"GET /api/teams/79169/zones":  `[]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		// Deletion waits until the zone is no longer listed
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			server.checkRequest("DELETE /api/zones/1", ""),
+			server.checkRequest("GET /api/teams/79169/zones", ""),
+		),
+		Steps: []resource.TestStep{
+			// Invalid SES timeout testing
+			{
+				Config:      testAccZoneResourceConfig("example.com", "soon", true),
+				ExpectError: regexp.MustCompile("Invalid SES Timeout"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccZoneResourceConfig("example.com", "30m", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					server.checkRequest("POST /api/teams/79169/zones", `{"cloud_provider_id": 1, "zone": "example.com"}`),
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "id", "1"),
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "zone", "example.com"),
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "nameservers.#", "2"),
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "nameservers.1", "ns-2.awsdns-02.com"),
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "ses_verified", "true"),
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "importing", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "laravelvapor_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Creation options are not returned by the API
+				ImportStateVerifyIgnore: []string{"wait_for_ses", "ses_timeout", "wait_for_deletion"},
+			},
+			// Creation options update testing, the zone is kept as it is
+			{
+				Config: testAccZoneResourceConfig("example.com", "1h", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_zone.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "ses_timeout", "1h"),
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "ses_verified", "true"),
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "records_count", "4"),
+					server.checkRequestCount("POST /api/teams/79169/zones", 1),
+				),
+			},
+			// Deletion wait toggle testing, the zone is kept as it is
+			{
+				Config: testAccZoneResourceConfig("example.com", "1h", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_zone.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "wait_for_deletion", "false"),
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "ses_verified", "true"),
+				),
+			},
+			{
+				Config: testAccZoneResourceConfig("example.com", "1h", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("laravelvapor_zone.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("laravelvapor_zone.test", "wait_for_deletion", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccZoneResourceConfig(zone string, sesTimeout string, waitForDeletion bool) string {
+	return fmt.Sprintf(`
+resource "laravelvapor_zone" "test" {
+  team_id           = 79169
+  cloud_provider_id = 1
+  zone              = %[1]q
+  wait_for_ses      = true
+  ses_timeout       = %[2]q
+  wait_for_deletion = %[3]t
+}
+`, zone, sesTimeout, waitForDeletion)
+}
+
+func TestNameserversValue(t *testing.T) {
+	// Freshly created zones have no nameservers yet
+	nameservers, diags := nameserversValue(context.Background(), nil)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if nameservers.IsNull() || len(nameservers.Elements()) != 0 {
+		t.Errorf("expected an empty list, got %s", nameservers)
+	}
+
+	nameservers, _ = nameserversValue(context.Background(), VaporNameservers{"ns-1.awsdns.com"})
+
+	if len(nameservers.Elements()) != 1 {
+		t.Errorf("expected a single nameserver, got %s", nameservers)
+	}
+}
+
+func TestZoneResourceModelSetComputedKeepsConfiguredZone(t *testing.T) {
+	tests := map[string]struct {
+		configured types.String
+		api        string
+		expected   string
+	}{
+		"normalized by the api": {configured: types.StringValue("Example.com."), api: "example.com", expected: "Example.com."},
+		"imported":              {configured: types.StringNull(), api: "example.com", expected: "example.com"},
+		"changed upstream":      {configured: types.StringValue("example.com"), api: "example.org", expected: "example.org"},
+		"missing from response": {configured: types.StringValue("example.com"), api: "", expected: "example.com"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := ZoneResourceModel{Zone: test.configured}
+
+			if diags := data.setComputed(context.Background(), VaporZone{Id: 1, Zone: test.api}); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if data.Zone.ValueString() != test.expected {
+				t.Errorf("expected zone %q, got %s", test.expected, data.Zone)
+			}
+		})
+	}
+}
+
+func TestZoneResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &ZoneResource{}
+	schemaResp := fwresource.SchemaResponse{}
+
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := map[string]struct {
+		id        string
+		wantError bool
+	}{
+		"numeric":      {id: "7"},
+		"not a number": {id: "example.com", wantError: true},
+		"out of range": {id: "4294967297", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := fwresource.ImportStateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: test.id}, &resp)
+
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Fatalf("expected import error to be %t, got diagnostics: %v", test.wantError, resp.Diagnostics)
+			}
+
+			if test.wantError {
+				return
+			}
+
+			var id types.Int32
+
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+
+			if id.ValueInt32() != 7 {
+				t.Errorf("expected zone ID 7, got %s", id)
+			}
+		})
+	}
+}
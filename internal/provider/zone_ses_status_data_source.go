@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneSesStatusDataSource{}
+
+func NewZoneSesStatusDataSource() datasource.DataSource {
+	return &ZoneSesStatusDataSource{}
+}
+
+// ZoneSesStatusDataSource defines the data source implementation.
+type ZoneSesStatusDataSource struct {
+	client VaporClient
+}
+
+// ZoneSesStatusDataSourceModel describes the data source data model.
+type ZoneSesStatusDataSourceModel struct {
+	ZoneId         types.Int32 `tfsdk:"zone_id"`
+	SesVerified    types.Bool  `tfsdk:"ses_verified"`
+	PendingRecords types.List  `tfsdk:"pending_records"`
+}
+
+// ZoneSesRecordModel describes a DNS record required by SES verification.
+type ZoneSesRecordModel struct {
+	Type  types.String `tfsdk:"type"`
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+var zoneSesRecordAttrTypes = map[string]attr.Type{
+	"type":  types.StringType,
+	"name":  types.StringType,
+	"value": types.StringType,
+}
+
+func (d *ZoneSesStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_ses_status"
+}
+
+func (d *ZoneSesStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Get the SES verification status of a DNS zone, always fetched fresh from Vapor",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int32Attribute{
+				MarkdownDescription: "Zone ID",
+				Required:            true,
+			},
+			"ses_verified": schema.BoolAttribute{
+				MarkdownDescription: "Is the zone verified for sending emails through SES",
+				Computed:            true,
+			},
+			"pending_records": schema.ListNestedAttribute{
+				MarkdownDescription: "DNS records SES still requires, empty once the zone is verified",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Record type",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Record name",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Record value",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZoneSesStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZoneSesStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneSesStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := d.client.GetZone(ctx, int(data.ZoneId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zone, got error: %s", err))
+		return
+	}
+
+	pendingRecords, diags := sesRecordsValue(ctx, zone.pendingSesRecords())
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SesVerified = types.BoolValue(zone.SesVerified)
+	data.PendingRecords = pendingRecords
+
+	tflog.Trace(ctx, "read zone ses status data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sesRecordsValue converts the SES records to a list of nested objects.
+func sesRecordsValue(ctx context.Context, records []VaporZoneSesRecord) (types.List, diag.Diagnostics) {
+	recordModels := make([]ZoneSesRecordModel, 0, len(records))
+
+	for _, record := range records {
+		recordModels = append(recordModels, ZoneSesRecordModel{
+			Type:  types.StringValue(record.Type),
+			Name:  types.StringValue(record.Name),
+			Value: types.StringValue(record.Value),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: zoneSesRecordAttrTypes}, recordModels)
+}
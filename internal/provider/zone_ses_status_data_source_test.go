@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccZoneSesStatusDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/zones/1": `{"id": 1, "team_id": 79169, "cloud_provider_id": 1, "zone": "example.com", "nameservers": ["ns-1.awsdns-01.org", "ns-2.awsdns-02.com"], "ses_verified": false, "records_count": 4, "cloud_provider": {"id": 1, "name": "production", "type": "aws", "uuid": "2b6e3f9a", "role_arn": "arn:aws:iam::123456789012:role/laravel-vapor-role"}, "ses_dns_records": [{"type": "CNAME", "name": "abc._domainkey.example.com", "value": "abc.dkim.amazonses.com"}]}`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccZoneSesStatusDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_ses_status.test", "zone_id", "1"),
+					server.checkRequest("GET /api/zones/1", ""),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_ses_status.test", "ses_verified", "false"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_ses_status.test", "pending_records.#", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_ses_status.test", "pending_records.0.type", "CNAME"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_ses_status.test", "pending_records.0.name", "abc._domainkey.example.com"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zone_ses_status.test", "pending_records.0.value", "abc.dkim.amazonses.com"),
+				),
+			},
+		},
+	})
+}
+
+const testAccZoneSesStatusDataSourceConfig = `
+data "laravelvapor_zone_ses_status" "test" {
+  zone_id = 1
+}
+`
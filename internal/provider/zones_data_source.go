@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZonesDataSource{}
+
+func NewZonesDataSource() datasource.DataSource {
+	return &ZonesDataSource{}
+}
+
+// ZonesDataSource defines the data source implementation.
+type ZonesDataSource struct {
+	client VaporClient
+}
+
+// ZonesDataSourceModel describes the data source data model.
+type ZonesDataSourceModel struct {
+	TeamId types.Int32 `tfsdk:"team_id"`
+	Zones  types.List  `tfsdk:"zones"`
+}
+
+// ZoneModel describes a zone object in data source models.
+type ZoneModel struct {
+	Id           types.Int32  `tfsdk:"id"`
+	Zone         types.String `tfsdk:"zone"`
+	Nameservers  types.List   `tfsdk:"nameservers"`
+	SesVerified  types.Bool   `tfsdk:"ses_verified"`
+	RecordsCount types.Int32  `tfsdk:"records_count"`
+}
+
+var zoneAttrTypes = map[string]attr.Type{
+	"id":            types.Int32Type,
+	"zone":          types.StringType,
+	"nameservers":   types.ListType{ElemType: types.StringType},
+	"ses_verified":  types.BoolType,
+	"records_count": types.Int32Type,
+}
+
+func newZoneModel(ctx context.Context, zone VaporZone) (ZoneModel, diag.Diagnostics) {
+	nameservers, diags := nameserversValue(ctx, zone.Nameservers)
+
+	return ZoneModel{
+		Id:           types.Int32Value(int32(zone.Id)),
+		Zone:         types.StringValue(zone.Zone),
+		Nameservers:  nameservers,
+		SesVerified:  types.BoolValue(zone.SesVerified),
+		RecordsCount: types.Int32Value(int32(zone.RecordsCount)),
+	}, diags
+}
+
+func (d *ZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zones"
+}
+
+func (d *ZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "List all DNS zones of a team",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int32Attribute{
+				MarkdownDescription: "Team ID",
+				Required:            true,
+			},
+			"zones": schema.ListNestedAttribute{
+				MarkdownDescription: "Zones list",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "Zone ID",
+							Computed:            true,
+						},
+						"zone": schema.StringAttribute{
+							MarkdownDescription: "Zone domain name",
+							Computed:            true,
+						},
+						"nameservers": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Zone nameservers",
+							Computed:            true,
+						},
+						"ses_verified": schema.BoolAttribute{
+							MarkdownDescription: "Is the zone verified for sending emails through SES",
+							Computed:            true,
+						},
+						"records_count": schema.Int32Attribute{
+							MarkdownDescription: "Number of DNS records in the zone",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(VaporClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected VaporClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZonesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zones, err := d.client.GetZones(ctx, int(data.TeamId.ValueInt32()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read zones, got error: %s", err))
+		return
+	}
+
+	zoneModels := []ZoneModel{}
+
+	for _, zone := range zones {
+		zoneModel, diags := newZoneModel(ctx, zone)
+
+		resp.Diagnostics.Append(diags...)
+
+		zoneModels = append(zoneModels, zoneModel)
+	}
+
+	zonesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: zoneAttrTypes}, zoneModels)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Zones = zonesValue
+
+	tflog.Trace(ctx, "read zones data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccZonesDataSource(t *testing.T) {
+	server := newTestServer(t, testRoutes{
+		"GET /api/teams/79169/zones": `[{"id": 1, "team_id": 79169, "zone": "example.com", "nameservers": ["ns-1.awsdns-01.org"], "ses_verified": true, "records_count": 4}, {"id": 2, "team_id": 79169, "zone": "example.org", "nameservers": null}]`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithHost(t, server.URL),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccZonesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.laravelvapor_zones.test", "team_id", "79169"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zones.test", "zones.#", "2"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zones.test", "zones.0.id", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zones.test", "zones.0.zone", "example.com"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zones.test", "zones.0.nameservers.#", "1"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zones.test", "zones.0.ses_verified", "true"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zones.test", "zones.0.records_count", "4"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zones.test", "zones.1.zone", "example.org"),
+					resource.TestCheckResourceAttr("data.laravelvapor_zones.test", "zones.1.nameservers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccZonesDataSourceConfig = `
+data "laravelvapor_zones" "test" {
+  team_id = 79169
+}
+`